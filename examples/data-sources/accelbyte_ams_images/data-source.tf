@@ -0,0 +1,4 @@
+data "accelbyte_ams_images" "release_images" {
+  namespace = "providertest"
+  tag       = "release"
+}
@@ -0,0 +1,3 @@
+data "accelbyte_ams_regions" "regions" {
+  namespace = "providertest"
+}
@@ -0,0 +1,33 @@
+resource "accelbyte_achievement" "win_100_matches" {
+  namespace        = "mygame"
+  achievement_code = "win-100-matches"
+
+  default_language = "en"
+  name = {
+    en = "Veteran"
+    sv = "Veteran"
+  }
+  description = {
+    en = "Win 100 matches"
+    sv = "Vinn 100 matcher"
+  }
+
+  locked_icons = [
+    {
+      slug = "256"
+      url  = "https://cdn.example.com/achievements/veteran-locked.png"
+    }
+  ]
+  unlocked_icons = [
+    {
+      slug = "256"
+      url  = "https://cdn.example.com/achievements/veteran.png"
+    }
+  ]
+
+  incremental = true
+  goal_value  = 100
+  stat_code   = "matches-won"
+
+  tags = ["progression"]
+}
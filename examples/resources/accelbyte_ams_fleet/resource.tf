@@ -0,0 +1,47 @@
+resource "accelbyte_ams_fleet" "fleet" {
+  namespace = "providertest"
+  name      = "test-fleet"
+
+  image_id     = "0123456789abcdef0123456789abcdef"
+  command_line = "-log"
+
+  ports = [
+    {
+      name     = "default"
+      protocol = "udp"
+    }
+  ]
+
+  ds_host_configuration = {
+    instance_id    = "fedcba9876543210fedcba9876543210"
+    servers_per_vm = 2
+  }
+
+  regions = [
+    {
+      region           = "us-east-2"
+      buffer_size      = 1
+      min_server_count = 0
+      max_server_count = 10
+    },
+    {
+      region           = "eu-central-1"
+      buffer_size      = 1
+      min_server_count = 0
+      max_server_count = 10
+    }
+  ]
+
+  claim_keys = ["test"]
+
+  sampling_rules = {
+    coredumps_crashed = {
+      collect    = true
+      percentage = 100
+    }
+    logs_crashed = {
+      collect    = true
+      percentage = 100
+    }
+  }
+}
@@ -0,0 +1,15 @@
+resource "accelbyte_chat_configuration" "mygame" {
+  namespace = "mygame"
+
+  enable_profanity_filter      = true
+  enable_manual_topic_creation = false
+
+  chat_rate_limit_burst    = 20
+  chat_rate_limit_duration = 60
+
+  max_chat_message_length = 500
+
+  spam_chat_burst    = 10
+  spam_chat_duration = 10
+  spam_mute_duration = 300
+}
@@ -0,0 +1,15 @@
+resource "accelbyte_chat_profanity_dictionary" "mygame" {
+  namespace = "mygame"
+
+  words = [
+    {
+      word            = "ass"
+      false_positives = ["class", "pass", "assassin"]
+    },
+    {
+      word            = "noob"
+      word_type       = "INSULT"
+      false_negatives = ["n00b"]
+    },
+  ]
+}
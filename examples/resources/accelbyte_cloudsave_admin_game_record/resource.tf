@@ -0,0 +1,24 @@
+resource "accelbyte_cloudsave_admin_game_record" "map_rotation" {
+  namespace = "mygame"
+  key       = "map-rotation"
+
+  value = jsonencode({
+    maps             = ["harbor", "canyon", "station"]
+    rotation_minutes = 30
+  })
+
+  tags = ["config"]
+}
+
+resource "accelbyte_cloudsave_admin_game_record" "winter_event" {
+  namespace = "mygame"
+  key       = "winter-event-tuning"
+
+  value = jsonencode({
+    xp_multiplier = 2.0
+  })
+
+  ttl_config = {
+    expires_at = "2026-01-07T00:00:00Z"
+  }
+}
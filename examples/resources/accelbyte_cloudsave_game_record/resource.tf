@@ -0,0 +1,12 @@
+resource "accelbyte_cloudsave_game_record" "daily_challenges" {
+  namespace = "mygame"
+  key       = "daily-challenges"
+
+  value = jsonencode({
+    challenges = ["win-3-matches", "collect-100-coins"]
+  })
+
+  # Only game servers may modify this record; game clients can read it
+  set_by = "SERVER"
+  tags   = ["live-ops"]
+}
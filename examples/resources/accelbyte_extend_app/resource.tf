@@ -0,0 +1,25 @@
+resource "accelbyte_extend_app" "session_dsm" {
+  namespace = "providertest"
+  name      = "session-dsm"
+
+  scenario    = "function-override"
+  description = "Session DSM override for game sessions"
+
+  min_replicas   = 1
+  max_replicas   = 3
+  cpu_millicores = 200
+  memory_mib     = 256
+
+  environment_variables = {
+    LOG_LEVEL = "info"
+  }
+
+  secrets = {
+    THIRD_PARTY_API_KEY = var.third_party_api_key
+  }
+}
+
+variable "third_party_api_key" {
+  type      = string
+  sensitive = true
+}
@@ -0,0 +1,43 @@
+resource "accelbyte_group_configuration" "clans" {
+  namespace          = "mygame"
+  configuration_code = "clans"
+
+  name             = "Clans"
+  description      = "Player-run clans"
+  group_max_member = 50
+
+  group_admin_role_id  = accelbyte_group_member_role.clan_leader.member_role_id
+  group_member_role_id = accelbyte_group_member_role.clan_member.member_role_id
+
+  allow_multiple = false
+
+  global_rules = [
+    {
+      allowed_action = "joinGroup"
+      rule_details = [
+        {
+          rule_attribute = "player-level"
+          rule_criteria  = "MINIMUM"
+          rule_value     = 10
+        },
+      ]
+    },
+  ]
+}
+
+resource "accelbyte_group_member_role" "clan_leader" {
+  namespace        = "mygame"
+  member_role_name = "Clan Leader"
+
+  member_role_permissions = [
+    {
+      resource_name = "GROUP:INVITE"
+      action        = 1 # Create
+    },
+  ]
+}
+
+resource "accelbyte_group_member_role" "clan_member" {
+  namespace        = "mygame"
+  member_role_name = "Clan Member"
+}
@@ -0,0 +1,20 @@
+resource "accelbyte_group_member_role" "clan_leader" {
+  namespace        = "mygame"
+  member_role_name = "Clan Leader"
+
+  member_role_permissions = [
+    {
+      resource_name = "GROUP:INVITE"
+      action        = 1 # Create
+    },
+    {
+      resource_name = "GROUP:KICK"
+      action        = 1 # Create
+    },
+  ]
+}
+
+resource "accelbyte_group_member_role" "clan_member" {
+  namespace        = "mygame"
+  member_role_name = "Clan Member"
+}
@@ -0,0 +1,30 @@
+resource "accelbyte_iam_client" "game_server" {
+  namespace   = "providertest"
+  name        = "game-server"
+  client_type = "Confidential"
+
+  description = "Used by dedicated servers"
+
+  permissions = [
+    {
+      resource = "NAMESPACE:providertest:SESSION:GAME"
+      action   = 2 + 4 # Read + Update
+    },
+    {
+      resource = "ADMIN:NAMESPACE:providertest:AMS:SERVER"
+      action   = 2 # Read
+    },
+  ]
+
+  oauth_access_token_expiration           = 1
+  oauth_access_token_expiration_time_unit = "HOURS"
+}
+
+output "game_server_client_id" {
+  value = accelbyte_iam_client.game_server.client_id
+}
+
+output "game_server_client_secret" {
+  value     = accelbyte_iam_client.game_server.client_secret
+  sensitive = true
+}
@@ -0,0 +1,29 @@
+resource "accelbyte_iam_role" "live_ops" {
+  name       = "Live Ops"
+  admin_role = true
+
+  permissions = [
+    {
+      resource = "ADMIN:NAMESPACE:providertest:SESSION:CONFIGURATION"
+      action   = 2 # Read
+    },
+    {
+      resource = "ADMIN:NAMESPACE:providertest:MATCHMAKING:POOL"
+      action   = 1 + 2 + 4 + 8 # Create + Read + Update + Delete
+    },
+  ]
+
+  managers = [
+    {
+      namespace = "providertest"
+      user_id   = "0123456789abcdef0123456789abcdef"
+    },
+  ]
+
+  members = [
+    {
+      namespace = "providertest"
+      user_id   = "fedcba9876543210fedcba9876543210"
+    },
+  ]
+}
@@ -0,0 +1,14 @@
+resource "accelbyte_inventory_configuration" "backpack" {
+  namespace = "mygame"
+
+  code        = "backpack"
+  name        = "Backpack"
+  description = "Items carried by the player"
+
+  initial_max_slots      = 20
+  max_slots              = 100
+  max_instances_per_user = 1
+
+  # Uncomment to allow max_slots to be lowered in-place
+  # allow_shrink = true
+}
@@ -0,0 +1,4 @@
+resource "accelbyte_inventory_item_type" "weapon" {
+  namespace = "mygame"
+  name      = "weapon"
+}
@@ -0,0 +1,24 @@
+resource "accelbyte_statistic" "mmr" {
+  namespace = "mygame"
+  stat_code = "mmr"
+  name      = "Matchmaking rating"
+
+  default_value = 1000
+}
+
+resource "accelbyte_leaderboard" "mmr" {
+  namespace        = "mygame"
+  leaderboard_code = "mmr"
+  name             = "Top players"
+
+  stat_code  = accelbyte_statistic.mmr.stat_code
+  start_time = "2025-01-01T00:00:00Z"
+  descending = true
+
+  weekly = {
+    reset_day  = 1
+    reset_time = "06:00"
+  }
+
+  season_period = 90
+}
@@ -0,0 +1,8 @@
+resource "accelbyte_legal_policy" "terms_of_service" {
+  namespace          = "mygame"
+  name               = "Terms of Service"
+  policy_type        = "Legal Document"
+  affected_countries = ["US", "SE"]
+  description        = "Terms of service for My Game"
+  tags               = ["mandatory"]
+}
@@ -0,0 +1,23 @@
+resource "accelbyte_legal_policy" "terms_of_service" {
+  namespace          = "mygame"
+  name               = "Terms of Service"
+  policy_type        = "Legal Document"
+  affected_countries = ["US", "SE"]
+}
+
+resource "accelbyte_legal_policy_version" "terms_of_service_us_1_0" {
+  namespace       = accelbyte_legal_policy.terms_of_service.namespace
+  policy_id       = accelbyte_legal_policy.terms_of_service.policy_ids["US"]
+  display_version = "1.0"
+
+  localizations = [
+    {
+      locale_code  = "en"
+      content_type = "text/html"
+      content      = file("terms-of-service.en.html")
+    },
+  ]
+  default_locale_code = "en"
+
+  published = true
+}
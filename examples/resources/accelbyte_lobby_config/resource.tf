@@ -0,0 +1,13 @@
+resource "accelbyte_lobby_config" "mygame" {
+  namespace = "mygame"
+
+  enable_chat           = true
+  profanity_filter      = true
+  chat_rate_limit_burst = 20
+
+  auto_kick_on_disconnect       = true
+  auto_kick_on_disconnect_delay = 60
+
+  concurrent_users_limit = 100000
+  max_party_member       = 4
+}
@@ -0,0 +1,19 @@
+resource "accelbyte_namespace" "feature_branch" {
+  namespace    = "mygamefeaturex"
+  display_name = "My Game (feature X)"
+}
+
+# Other resources can be placed in the namespace by referencing it
+resource "accelbyte_match_ruleset" "feature_branch" {
+  namespace = accelbyte_namespace.feature_branch.namespace
+  name      = "default"
+
+  configuration = jsonencode({
+    "alliance" : {
+      "min_number" : 1,
+      "max_number" : 3,
+      "player_min_number" : 1,
+      "player_max_number" : 4
+    }
+  })
+}
@@ -0,0 +1,30 @@
+resource "accelbyte_platform_campaign" "launch" {
+  namespace   = "mygame"
+  name        = "Launch giveaway"
+  description = "Codes handed out at the launch event"
+
+  items = [
+    {
+      item_id   = "8f2bc3c5d2e84a6e9f3c8d7e6b5a4c3d"
+      item_name = "Gold pack"
+      quantity  = 1
+    },
+  ]
+
+  max_redeem_count_per_code              = 1
+  max_redeem_count_per_code_per_user     = 1
+  max_redeem_count_per_campaign_per_user = 1
+
+  redeem_start = "2025-01-01T00:00:00Z"
+  redeem_end   = "2025-02-01T00:00:00Z"
+
+  code_batch = {
+    batch_name = "launch-event"
+    quantity   = 500
+  }
+}
+
+output "launch_codes" {
+  value     = accelbyte_platform_campaign.launch.codes
+  sensitive = true
+}
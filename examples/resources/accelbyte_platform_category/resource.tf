@@ -0,0 +1,26 @@
+resource "accelbyte_platform_category" "games" {
+  namespace     = accelbyte_platform_store.draft.namespace
+  store_id      = accelbyte_platform_store.draft.store_id
+  category_path = "/games"
+
+  localization_display_names = {
+    en = "Games"
+    sv = "Spel"
+  }
+}
+
+resource "accelbyte_platform_category" "games_rpg" {
+  namespace     = accelbyte_platform_category.games.namespace
+  store_id      = accelbyte_platform_category.games.store_id
+  category_path = "${accelbyte_platform_category.games.category_path}/rpg"
+
+  localization_display_names = {
+    en = "Role-playing games"
+    sv = "Rollspel"
+  }
+}
+
+resource "accelbyte_platform_store" "draft" {
+  namespace = "mygame"
+  title     = "My Game Store"
+}
@@ -0,0 +1,24 @@
+resource "accelbyte_platform_currency" "usd" {
+  namespace       = "mygame"
+  currency_code   = "USD"
+  currency_type   = "REAL"
+  currency_symbol = "$"
+  decimals        = 2
+
+  localization_descriptions = {
+    en = "US Dollar"
+  }
+}
+
+resource "accelbyte_platform_currency" "gold" {
+  namespace       = "mygame"
+  currency_code   = "GOLD"
+  currency_type   = "VIRTUAL"
+  currency_symbol = "G"
+  decimals        = 0
+
+  localization_descriptions = {
+    en = "Gold coins"
+    sv = "Guldmynt"
+  }
+}
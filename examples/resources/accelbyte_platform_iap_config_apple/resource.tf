@@ -0,0 +1,17 @@
+variable "apple_shared_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "accelbyte_platform_iap_config_apple" "apple" {
+  namespace = "mygame"
+  bundle_id = "com.example.mygame"
+  version   = "V2"
+
+  app_apple_id = 1234567890
+  issuer_id    = "57246542-96fe-1a63-e053-0824d011072a"
+  key_id       = "2X9R4HXF34"
+
+  shared_secret = var.apple_shared_secret
+  auth_key      = file("AuthKey_2X9R4HXF34.p8")
+}
@@ -0,0 +1,4 @@
+resource "accelbyte_platform_iap_config_epic_games" "epic_games" {
+  namespace  = "mygame"
+  sandbox_id = "0123456789abcdef0123456789abcdef"
+}
@@ -0,0 +1,8 @@
+resource "accelbyte_platform_iap_config_google" "google" {
+  namespace          = "mygame"
+  application_name   = "My Game"
+  package_name       = "com.example.mygame"
+  service_account_id = "iap-sync@my-game.iam.gserviceaccount.com"
+
+  service_account_p12_key = filebase64("service-account.p12")
+}
@@ -0,0 +1,12 @@
+variable "psn_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "accelbyte_platform_iap_config_play_station" "play_station" {
+  namespace   = "mygame"
+  environment = "sp-int"
+
+  back_office_server_client_id     = "00000000-0000-0000-0000-000000000000"
+  back_office_server_client_secret = var.psn_client_secret
+}
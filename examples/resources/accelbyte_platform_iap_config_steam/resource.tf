@@ -0,0 +1,11 @@
+variable "steam_publisher_authentication_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "accelbyte_platform_iap_config_steam" "steam" {
+  namespace = "mygame"
+  app_id    = "480"
+
+  publisher_authentication_key = var.steam_publisher_authentication_key
+}
@@ -0,0 +1,11 @@
+variable "xbox_business_partner_cert_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "accelbyte_platform_iap_config_xbox" "xbox" {
+  namespace = "mygame"
+
+  business_partner_cert_p12      = filebase64("business-partner.p12")
+  business_partner_cert_password = var.xbox_business_partner_cert_password
+}
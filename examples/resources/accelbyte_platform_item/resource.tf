@@ -0,0 +1,99 @@
+resource "accelbyte_platform_item" "sword" {
+  namespace     = "mygame"
+  store_id      = "0123456789abcdef0123456789abcdef"
+  item_type     = "INGAMEITEM"
+  name          = "sword"
+  category_path = "/weapons"
+  sku           = "weapon-sword"
+
+  localizations = {
+    en = {
+      title       = "Sword"
+      description = "A sharp sword"
+    }
+  }
+
+  region_data = [
+    {
+      region             = "US"
+      currency_code      = "USD"
+      currency_namespace = "mygame"
+      currency_type      = "REAL"
+      price              = 499
+    },
+    {
+      region             = "US"
+      currency_code      = "GOLD"
+      currency_namespace = "mygame"
+      currency_type      = "VIRTUAL"
+      price              = 100
+
+      discount_percentage  = 20
+      discount_purchase_at = "2025-06-01T00:00:00Z"
+      discount_expire_at   = "2025-06-30T00:00:00Z"
+    },
+  ]
+
+  tags = ["weapon"]
+}
+
+resource "accelbyte_platform_item" "gold_pack" {
+  namespace        = "mygame"
+  store_id         = "0123456789abcdef0123456789abcdef"
+  item_type        = "COINS"
+  name             = "gold-pack-1000"
+  category_path    = "/currency"
+  entitlement_type = "CONSUMABLE"
+  use_count        = 1000
+
+  localizations = {
+    en = {
+      title = "1000 Gold"
+    }
+  }
+
+  region_data = [
+    {
+      region             = "US"
+      currency_code      = "USD"
+      currency_namespace = "mygame"
+      currency_type      = "REAL"
+      price              = 999
+    },
+  ]
+
+  coins = {
+    target_currency_code = "GOLD"
+  }
+}
+
+resource "accelbyte_platform_item" "starter_bundle" {
+  namespace     = "mygame"
+  store_id      = "0123456789abcdef0123456789abcdef"
+  item_type     = "BUNDLE"
+  name          = "starter-bundle"
+  category_path = "/bundles"
+
+  localizations = {
+    en = {
+      title = "Starter Bundle"
+    }
+  }
+
+  region_data = [
+    {
+      region             = "US"
+      currency_code      = "USD"
+      currency_namespace = "mygame"
+      currency_type      = "REAL"
+      price              = 1299
+    },
+  ]
+
+  bundle = {
+    bundled_items = {
+      (accelbyte_platform_item.sword.item_id)     = 1
+      (accelbyte_platform_item.gold_pack.item_id) = 2
+    }
+  }
+}
@@ -0,0 +1,33 @@
+resource "accelbyte_platform_reward" "kills" {
+  namespace   = "mygame"
+  reward_code = "KILLS_MILESTONES"
+  event_topic = "statistic"
+  description = "Rewards for reaching kill milestones"
+
+  reward_conditions = [
+    {
+      condition_name = "100_kills"
+      condition      = "$[?(@.statCode == 'kills' && @.latestValue >= 100)]"
+      event_name     = "statItemUpdated"
+      reward_items = [
+        {
+          item_id  = "8f2bc3c5d2e84a6e9f3c8d7e6b5a4c3d"
+          quantity = 1
+        },
+      ]
+    },
+    {
+      condition_name = "1000_kills"
+      condition      = "$[?(@.statCode == 'kills' && @.latestValue >= 1000)]"
+      event_name     = "statItemUpdated"
+      reward_items = [
+        {
+          sku      = "GOLD_PACK_100"
+          quantity = 5
+        },
+      ]
+    },
+  ]
+
+  max_awarded_per_user = 2
+}
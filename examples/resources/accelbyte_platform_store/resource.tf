@@ -0,0 +1,11 @@
+resource "accelbyte_platform_store" "draft" {
+  namespace = "mygame"
+  title     = "My Game Store"
+
+  description      = "Draft store for My Game"
+  default_language = "en"
+  default_region   = "US"
+
+  supported_languages = ["en", "sv"]
+  supported_regions   = ["US", "SE"]
+}
@@ -0,0 +1,26 @@
+resource "accelbyte_platform_store_publish" "store" {
+  namespace = accelbyte_platform_store.draft.namespace
+  store_id  = accelbyte_platform_store.draft.store_id
+
+  # Publish the draft store again whenever its categories change
+  triggers = {
+    categories = sha1(jsonencode([
+      accelbyte_platform_category.games,
+    ]))
+  }
+}
+
+resource "accelbyte_platform_store" "draft" {
+  namespace = "mygame"
+  title     = "My Game Store"
+}
+
+resource "accelbyte_platform_category" "games" {
+  namespace     = accelbyte_platform_store.draft.namespace
+  store_id      = accelbyte_platform_store.draft.store_id
+  category_path = "/games"
+
+  localization_display_names = {
+    en = "Games"
+  }
+}
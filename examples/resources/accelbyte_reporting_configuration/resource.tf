@@ -0,0 +1,41 @@
+resource "accelbyte_reporting_reason" "harassment" {
+  namespace = "mygame"
+  title     = "Harassment"
+}
+
+resource "accelbyte_reporting_configuration" "mygame" {
+  namespace = "mygame"
+
+  # Each player can submit at most 10 reports per hour
+  time_interval_seconds             = 3600
+  user_max_report_per_time_interval = 10
+
+  category_limits = [
+    {
+      category              = "USER"
+      max_report_per_ticket = 100
+    },
+  ]
+
+  moderation_rules = [
+    {
+      category     = "CHAT"
+      reason       = accelbyte_reporting_reason.harassment.title
+      threshold    = 5
+      hide_content = true
+      delete_chat  = true
+    },
+    {
+      category  = "USER"
+      reason    = accelbyte_reporting_reason.harassment.title
+      threshold = 20
+
+      ban_account = {
+        type             = "CHAT_SEND"
+        duration_minutes = 1440
+        reason           = "HARASSMENT"
+        comment          = "Automatically banned after repeated harassment reports"
+      }
+    },
+  ]
+}
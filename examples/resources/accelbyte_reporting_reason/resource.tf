@@ -0,0 +1,12 @@
+resource "accelbyte_reporting_reason_group" "player" {
+  namespace = "mygame"
+  title     = "Player"
+}
+
+resource "accelbyte_reporting_reason" "harassment" {
+  namespace   = "mygame"
+  title       = "Harassment"
+  description = "The player is harassing, threatening or bullying others."
+
+  group_ids = [accelbyte_reporting_reason_group.player.group_id]
+}
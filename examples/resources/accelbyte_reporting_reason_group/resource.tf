@@ -0,0 +1,4 @@
+resource "accelbyte_reporting_reason_group" "player" {
+  namespace = "mygame"
+  title     = "Player"
+}
@@ -56,4 +56,7 @@ resource "accelbyte_session_template" "test" {
 
   // "Custom Attributes" screen
   custom_attributes = jsonencode({})
+
+  // Check that AMS offers all regions in ams_server.requested_regions during planning
+  validate_ams_regions = true
 }
@@ -0,0 +1,34 @@
+resource "accelbyte_statistic" "mmr" {
+  namespace = "mygame"
+  stat_code = "mmr"
+  name      = "Matchmaking rating"
+
+  default_value = 1000
+  minimum       = 0
+  maximum       = 5000
+
+  set_by = "SERVER"
+  tags   = ["matchmaking"]
+}
+
+# Matchmaking rulesets can reference the statistic by its code
+resource "accelbyte_match_ruleset" "ranked" {
+  namespace = "mygame"
+  name      = "ranked"
+
+  configuration = jsonencode({
+    "alliance" : {
+      "min_number" : 2,
+      "max_number" : 2,
+      "player_min_number" : 1,
+      "player_max_number" : 1
+    },
+    "matchingRule" : [
+      {
+        "attribute" : accelbyte_statistic.mmr.stat_code,
+        "criteria" : "distance",
+        "reference" : 200
+      }
+    ]
+  })
+}
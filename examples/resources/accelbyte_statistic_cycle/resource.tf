@@ -0,0 +1,23 @@
+resource "accelbyte_statistic" "season_mmr" {
+  namespace = "mygame"
+  stat_code = "season-mmr"
+  name      = "Seasonal matchmaking rating"
+
+  default_value = 1000
+}
+
+resource "accelbyte_statistic_cycle" "season" {
+  namespace = "mygame"
+  cycle_id  = "season1"
+  name      = "Season 1"
+
+  cycle_type    = "SEASONAL"
+  season_period = 90
+  reset_time    = "00:00"
+  start         = "2025-01-01T00:00:00Z"
+
+  stat_codes = [accelbyte_statistic.season_mmr.stat_code]
+
+  # Set status to "STOPPED" to stop the cycle
+  status = "ACTIVE"
+}
@@ -0,0 +1,33 @@
+variable "steam_web_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "accelbyte_third_party_login_platform" "steam" {
+  namespace   = "mygame"
+  platform_id = "steam"
+
+  app_id = "480"
+  secret = var.steam_web_api_key
+}
+
+variable "discord_client_secret" {
+  type      = string
+  sensitive = true
+}
+
+resource "accelbyte_third_party_login_platform" "discord" {
+  namespace   = "mygame"
+  platform_id = "discord"
+
+  client_id    = "123456789012345678"
+  secret       = var.discord_client_secret
+  redirect_uri = "https://mygame.example.com/auth/discord/callback"
+
+  registered_domains = [
+    {
+      domain  = "example.com"
+      role_id = "2c3a8d1f6e8a4b0a9d6e5f4c3b2a1d0e"
+    }
+  ]
+}
@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/achievement-sdk/pkg/achievementclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteAchievementModel is used by AccelByteAchievementResource.
+type AccelByteAchievementModel struct {
+	// Populated by user
+	Namespace       types.String `tfsdk:"namespace"`
+	AchievementCode types.String `tfsdk:"achievement_code"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Name types.Map `tfsdk:"name"` // map[string]string
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	DefaultLanguage types.String  `tfsdk:"default_language"`
+	Description     types.Map     `tfsdk:"description"`    // map[string]string
+	LockedIcons     types.List    `tfsdk:"locked_icons"`   // []AccelByteAchievementIconModel
+	UnlockedIcons   types.List    `tfsdk:"unlocked_icons"` // []AccelByteAchievementIconModel
+	Hidden          types.Bool    `tfsdk:"hidden"`
+	Incremental     types.Bool    `tfsdk:"incremental"`
+	Global          types.Bool    `tfsdk:"global"`
+	GoalValue       types.Float64 `tfsdk:"goal_value"`
+	StatCode        types.String  `tfsdk:"stat_code"`
+	Tags            types.Set     `tfsdk:"tags"` // []string
+}
+
+var AccelByteAchievementIconModelAttributeTypes = map[string]attr.Type{
+	"slug": types.StringType,
+	"url":  types.StringType,
+}
+
+type AccelByteAchievementIconModel struct {
+	Slug types.String `tfsdk:"slug"`
+	Url  types.String `tfsdk:"url"`
+}
+
+// Used by Create, Read and Update operations on achievements.
+// This copies data from the AccelByte API `achievement` to the TF state `data`.
+// All languages returned by the API are copied, so that importing an achievement retains all of its translations.
+func updateFromApiAchievement(ctx context.Context, data *AccelByteAchievementModel, achievement *achievementclientmodels.ModelsAchievementResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*achievement.Namespace)
+	data.AchievementCode = types.StringValue(*achievement.AchievementCode)
+	data.Id = types.StringValue(computeAchievementId(*achievement.Namespace, *achievement.AchievementCode))
+
+	name, nameDiags := mapValueFromEvenIfNil(ctx, types.StringType, achievement.Name)
+	data.Name = name
+	diags.Append(nameDiags...)
+
+	data.DefaultLanguage = types.StringPointerValue(achievement.DefaultLanguage)
+
+	description, descriptionDiags := mapValueFromEvenIfNil(ctx, types.StringType, achievement.Description)
+	data.Description = description
+	diags.Append(descriptionDiags...)
+
+	lockedIcons, lockedIconsDiags := fromApiAchievementIcons(ctx, achievement.LockedIcons)
+	data.LockedIcons = lockedIcons
+	diags.Append(lockedIconsDiags...)
+
+	unlockedIcons, unlockedIconsDiags := fromApiAchievementIcons(ctx, achievement.UnlockedIcons)
+	data.UnlockedIcons = unlockedIcons
+	diags.Append(unlockedIconsDiags...)
+
+	data.Hidden = types.BoolValue(achievement.Hidden != nil && *achievement.Hidden)
+	data.Incremental = types.BoolValue(achievement.Incremental != nil && *achievement.Incremental)
+	data.Global = types.BoolValue(achievement.Global != nil && *achievement.Global)
+
+	// The API omits the goal value when it is zero; an unset goal value in the TF state remains unset
+	if achievement.GoalValue != 0 || !data.GoalValue.IsNull() {
+		data.GoalValue = types.Float64Value(achievement.GoalValue)
+	}
+
+	data.StatCode = types.StringValue(achievement.StatCode)
+
+	tags, tagsDiags := setValueFromEvenIfNil(ctx, types.StringType, achievement.Tags)
+	data.Tags = tags
+	diags.Append(tagsDiags...)
+
+	return diags
+}
+
+func fromApiAchievementIcons(ctx context.Context, icons []*achievementclientmodels.ModelsIcon) (types.List, diag.Diagnostics) {
+
+	iconModels := []AccelByteAchievementIconModel{}
+	for _, icon := range icons {
+		iconModels = append(iconModels, AccelByteAchievementIconModel{
+			Slug: types.StringPointerValue(icon.Slug),
+			Url:  types.StringPointerValue(icon.URL),
+		})
+	}
+
+	return types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteAchievementIconModelAttributeTypes}, iconModels)
+}
+
+func toApiAchievementIcons(ctx context.Context, icons types.List) ([]*achievementclientmodels.ModelsIcon, diag.Diagnostics) {
+
+	iconModels := []AccelByteAchievementIconModel{}
+	diags := icons.ElementsAs(ctx, &iconModels, false)
+
+	apiIcons := []*achievementclientmodels.ModelsIcon{}
+	for _, icon := range iconModels {
+		apiIcons = append(apiIcons, &achievementclientmodels.ModelsIcon{
+			Slug: icon.Slug.ValueStringPointer(),
+			URL:  icon.Url.ValueStringPointer(),
+		})
+	}
+
+	return apiIcons, diags
+}
+
+// Used by the Update operation on achievements.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiAchievementUpdate(ctx context.Context, data AccelByteAchievementModel) (*achievementclientmodels.ModelsAchievementUpdateRequest, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	achievement := &achievementclientmodels.ModelsAchievementUpdateRequest{
+		DefaultLanguage: data.DefaultLanguage.ValueStringPointer(),
+		Name:            map[string]string{},
+		Description:     map[string]string{},
+		Hidden:          data.Hidden.ValueBoolPointer(),
+		Incremental:     data.Incremental.ValueBoolPointer(),
+		Global:          data.Global.ValueBool(),
+		GoalValue:       data.GoalValue.ValueFloat64Pointer(),
+		StatCode:        data.StatCode.ValueStringPointer(),
+		Tags:            []string{},
+	}
+
+	if achievement.GoalValue == nil {
+		achievement.GoalValue = new(float64)
+	}
+
+	diags.Append(data.Name.ElementsAs(ctx, &achievement.Name, false)...)
+	diags.Append(data.Description.ElementsAs(ctx, &achievement.Description, false)...)
+	diags.Append(data.Tags.ElementsAs(ctx, &achievement.Tags, false)...)
+
+	lockedIcons, lockedIconsDiags := toApiAchievementIcons(ctx, data.LockedIcons)
+	achievement.LockedIcons = lockedIcons
+	diags.Append(lockedIconsDiags...)
+
+	unlockedIcons, unlockedIconsDiags := toApiAchievementIcons(ctx, data.UnlockedIcons)
+	achievement.UnlockedIcons = unlockedIcons
+	diags.Append(unlockedIconsDiags...)
+
+	return achievement, diags
+}
+
+// Used by the Create operation on achievements.
+// The create request has the same fields as the update request, plus the achievement code.
+func toApiAchievementCreate(ctx context.Context, data AccelByteAchievementModel) (*achievementclientmodels.ModelsAchievementRequest, diag.Diagnostics) {
+
+	update, diags := toApiAchievementUpdate(ctx, data)
+
+	return &achievementclientmodels.ModelsAchievementRequest{
+		AchievementCode: data.AchievementCode.ValueStringPointer(),
+		DefaultLanguage: update.DefaultLanguage,
+		Name:            update.Name,
+		Description:     update.Description,
+		Hidden:          update.Hidden,
+		Incremental:     update.Incremental,
+		Global:          update.Global,
+		GoalValue:       update.GoalValue,
+		StatCode:        update.StatCode,
+		Tags:            update.Tags,
+		LockedIcons:     update.LockedIcons,
+		UnlockedIcons:   update.UnlockedIcons,
+	}, diags
+}
+
+func computeAchievementId(namespace string, achievementCode string) string {
+	return fmt.Sprintf("%s/%s", namespace, achievementCode)
+}
@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/achievement-sdk/pkg/achievementclient/achievements"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/achievement"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteAchievementResource{}
+var _ resource.ResourceWithImportState = &AccelByteAchievementResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteAchievementResource{}
+
+func NewAccelByteAchievementResource() resource.Resource {
+	return &AccelByteAchievementResource{}
+}
+
+// AccelByteAchievementResource defines the resource implementation.
+type AccelByteAchievementResource struct {
+	client *achievement.AchievementsService
+}
+
+func (r *AccelByteAchievementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_achievement"
+}
+
+// Schema for the `locked_icons` and `unlocked_icons` attributes.
+func achievementIconsAttribute(markdownDescription string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: markdownDescription,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"slug": schema.StringAttribute{
+					MarkdownDescription: "Identifier of the icon, for example its size.",
+					Required:            true,
+				},
+				"url": schema.StringAttribute{
+					MarkdownDescription: "URL of the icon image.",
+					Required:            true,
+				},
+			},
+		},
+		Optional: true,
+		Computed: true,
+		Default:  listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: AccelByteAchievementIconModelAttributeTypes}, []attr.Value{})),
+	}
+}
+
+func (r *AccelByteAchievementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an [achievement](https://docs.accelbyte.io/gaming-services/services/engagement/achievements/). Names and descriptions are localized; importing an existing achievement brings in all of its languages.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the achievement. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"achievement_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the achievement. Lowercase characters, digits, or dashes. Changing this forces the achievement to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`), "must consist of lowercase characters or digits, optionally separated by single dashes"),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Achievement identifier, on the format `{{namespace}}/{{achievement_code}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.MapAttribute{
+				MarkdownDescription: "Display name of the achievement, keyed by language code; for example `{ en = \"First blood\" }`. Must contain an entry for `default_language`.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"default_language": schema.StringAttribute{
+				MarkdownDescription: "Language code used when a player's language is not available in `name` or `description`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("en"),
+			},
+			"description": schema.MapAttribute{
+				MarkdownDescription: "Description of the achievement, keyed by language code.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+			"locked_icons":   achievementIconsAttribute("Icons shown while the achievement is locked."),
+			"unlocked_icons": achievementIconsAttribute("Icons shown once the achievement is unlocked."),
+			"hidden": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, the achievement is not shown to players until it is unlocked.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"incremental": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, the achievement is unlocked once the statistic `stat_code` reaches `goal_value`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"global": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, the achievement is unlocked for all contributing players once the global statistic `stat_code` reaches `goal_value`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"goal_value": schema.Float64Attribute{
+				MarkdownDescription: "Value that the statistic must reach for the achievement to be unlocked. Required when `incremental` is `true`.",
+				Optional:            true,
+			},
+			"stat_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the statistic that tracks progress towards the achievement. Required when `incremental` is `true`. See the `accelbyte_statistic` resource.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags for the achievement.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *AccelByteAchievementResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteAchievementModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Incremental.ValueBool() {
+		if data.GoalValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("goal_value"),
+				"Missing required attribute",
+				"Attribute `goal_value` must be set when `incremental` is `true`",
+			)
+		}
+		if data.StatCode.IsNull() || (!data.StatCode.IsUnknown() && data.StatCode.ValueString() == "") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("stat_code"),
+				"Missing required attribute",
+				"Attribute `stat_code` must be set when `incremental` is `true`",
+			)
+		}
+	}
+
+	// The default language defaults to "en" when not set in the configuration
+	defaultLanguage := "en"
+	if data.DefaultLanguage.IsUnknown() || data.Name.IsUnknown() {
+		return
+	} else if !data.DefaultLanguage.IsNull() {
+		defaultLanguage = data.DefaultLanguage.ValueString()
+	}
+
+	if _, ok := data.Name.Elements()[defaultLanguage]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing default language",
+			fmt.Sprintf("Attribute `name` must contain an entry for the default language '%s'", defaultLanguage),
+		)
+	}
+}
+
+func (r *AccelByteAchievementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.AchievementsService
+}
+
+func (r *AccelByteAchievementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteAchievementModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiAchievement, apiAchievementDiags := toApiAchievementCreate(ctx, data)
+	resp.Diagnostics.Append(apiAchievementDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating achievement via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"achievementCode": data.AchievementCode,
+	})
+
+	createInput := &achievements.AdminCreateNewAchievementParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiAchievement,
+	}
+
+	achievementResponse, err := r.client.AdminCreateNewAchievementShort(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating achievement via AccelByte API", fmt.Sprintf("Unable to create achievement '%s' in namespace '%s', got error: %s", data.AchievementCode.ValueString(), createInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiAchievement(ctx, &data, achievementResponse)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteAchievementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteAchievementModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &achievements.AdminGetAchievementParams{
+		Namespace:       data.Namespace.ValueString(),
+		AchievementCode: data.AchievementCode.ValueString(),
+	}
+
+	achievementResponse, err := r.client.AdminGetAchievementShort(input)
+	if err != nil {
+		notFoundError := &achievements.AdminGetAchievementNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading achievement via AccelByte API", fmt.Sprintf("Unable to read achievement '%s' in namespace '%s', got error: %s", input.AchievementCode, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read achievement from AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"achievementCode": data.AchievementCode,
+		"achievement":     achievementResponse,
+	})
+
+	resp.Diagnostics.Append(updateFromApiAchievement(ctx, &data, achievementResponse)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteAchievementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteAchievementModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiAchievement, apiAchievementDiags := toApiAchievementUpdate(ctx, data)
+	resp.Diagnostics.Append(apiAchievementDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating achievement via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"achievementCode": data.AchievementCode,
+	})
+
+	input := &achievements.AdminUpdateAchievementParams{
+		Namespace:       data.Namespace.ValueString(),
+		AchievementCode: data.AchievementCode.ValueString(),
+		Body:            apiAchievement,
+	}
+
+	achievementResponse, err := r.client.AdminUpdateAchievementShort(input)
+	if err != nil {
+		notFoundError := &achievements.AdminUpdateAchievementNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Achievement '%s' does not exist in namespace '%s'", input.AchievementCode, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating achievement via AccelByte API", fmt.Sprintf("Unable to update achievement '%s' in namespace '%s', got error: %s", input.AchievementCode, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiAchievement(ctx, &data, achievementResponse)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteAchievementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteAchievementModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting achievement via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"achievementCode": data.AchievementCode,
+	})
+
+	input := &achievements.AdminDeleteAchievementParams{
+		Namespace:       data.Namespace.ValueString(),
+		AchievementCode: data.AchievementCode.ValueString(),
+	}
+	err := r.client.AdminDeleteAchievementShort(input)
+	if err != nil {
+		notFoundError := &achievements.AdminDeleteAchievementNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting achievement via AccelByte API", fmt.Sprintf("Unable to delete achievement '%s' in namespace '%s', got error: %s", input.AchievementCode, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteAchievementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "achievement_code")
+}
@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AccelByteAmsFleetModel is used by AccelByteAmsFleetResource.
+type AccelByteAmsFleetModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id      types.String `tfsdk:"id"`
+	FleetId types.String `tfsdk:"fleet_id"`
+
+	// Must be set by user during resource creation
+	Name                types.String `tfsdk:"name"`
+	ImageId             types.String `tfsdk:"image_id"`
+	DsHostConfiguration types.Object `tfsdk:"ds_host_configuration"` // AccelByteAmsFleetDsHostConfigurationModel
+	Regions             types.List   `tfsdk:"regions"`               // []AccelByteAmsFleetRegionModel
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Active        types.Bool   `tfsdk:"active"`
+	OnDemand      types.Bool   `tfsdk:"on_demand"`
+	CommandLine   types.String `tfsdk:"command_line"`
+	Ports         types.List   `tfsdk:"ports"`           // []AccelByteAmsFleetPortModel
+	ClaimKeys     types.List   `tfsdk:"claim_keys"`      // []string
+	Timeouts      types.Object `tfsdk:"server_timeouts"` // AccelByteAmsFleetTimeoutsModel
+	SamplingRules types.Object `tfsdk:"sampling_rules"`  // AccelByteAmsFleetSamplingRulesModel
+}
+
+var AccelByteAmsFleetDsHostConfigurationModelAttributeTypes = map[string]attr.Type{
+	"instance_id":    types.StringType,
+	"servers_per_vm": types.Int32Type,
+}
+
+type AccelByteAmsFleetDsHostConfigurationModel struct {
+	InstanceId   types.String `tfsdk:"instance_id"`
+	ServersPerVm types.Int32  `tfsdk:"servers_per_vm"`
+}
+
+var AccelByteAmsFleetRegionModelAttributeTypes = map[string]attr.Type{
+	"region":           types.StringType,
+	"buffer_size":      types.Int32Type,
+	"dynamic_buffer":   types.BoolType,
+	"min_server_count": types.Int32Type,
+	"max_server_count": types.Int32Type,
+}
+
+type AccelByteAmsFleetRegionModel struct {
+	Region         types.String `tfsdk:"region"`
+	BufferSize     types.Int32  `tfsdk:"buffer_size"`
+	DynamicBuffer  types.Bool   `tfsdk:"dynamic_buffer"`
+	MinServerCount types.Int32  `tfsdk:"min_server_count"`
+	MaxServerCount types.Int32  `tfsdk:"max_server_count"`
+}
+
+var AccelByteAmsFleetPortModelAttributeTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"protocol": types.StringType,
+}
+
+type AccelByteAmsFleetPortModel struct {
+	Name     types.String `tfsdk:"name"`
+	Protocol types.String `tfsdk:"protocol"`
+}
+
+var AccelByteAmsFleetTimeoutsModelAttributeTypes = map[string]attr.Type{
+	"creation_seconds":     types.Int64Type,
+	"drain_seconds":        types.Int64Type,
+	"session_seconds":      types.Int64Type,
+	"unresponsive_seconds": types.Int64Type,
+	"claim_seconds":        types.Int64Type,
+}
+
+type AccelByteAmsFleetTimeoutsModel struct {
+	CreationSeconds     types.Int64 `tfsdk:"creation_seconds"`
+	DrainSeconds        types.Int64 `tfsdk:"drain_seconds"`
+	SessionSeconds      types.Int64 `tfsdk:"session_seconds"`
+	UnresponsiveSeconds types.Int64 `tfsdk:"unresponsive_seconds"`
+	ClaimSeconds        types.Int64 `tfsdk:"claim_seconds"`
+}
+
+var AccelByteAmsFleetSamplingRuleModelAttributeTypes = map[string]attr.Type{
+	"collect":    types.BoolType,
+	"percentage": types.Int64Type,
+}
+
+type AccelByteAmsFleetSamplingRuleModel struct {
+	Collect    types.Bool  `tfsdk:"collect"`
+	Percentage types.Int64 `tfsdk:"percentage"`
+}
+
+var AccelByteAmsFleetSamplingRulesModelAttributeTypes = map[string]attr.Type{
+	"coredumps_crashed": types.ObjectType{AttrTypes: AccelByteAmsFleetSamplingRuleModelAttributeTypes},
+	"logs_crashed":      types.ObjectType{AttrTypes: AccelByteAmsFleetSamplingRuleModelAttributeTypes},
+	"logs_success":      types.ObjectType{AttrTypes: AccelByteAmsFleetSamplingRuleModelAttributeTypes},
+	"logs_unclaimed":    types.ObjectType{AttrTypes: AccelByteAmsFleetSamplingRuleModelAttributeTypes},
+}
+
+type AccelByteAmsFleetSamplingRulesModel struct {
+	CoredumpsCrashed types.Object `tfsdk:"coredumps_crashed"` // AccelByteAmsFleetSamplingRuleModel
+	LogsCrashed      types.Object `tfsdk:"logs_crashed"`      // AccelByteAmsFleetSamplingRuleModel
+	LogsSuccess      types.Object `tfsdk:"logs_success"`      // AccelByteAmsFleetSamplingRuleModel
+	LogsUnclaimed    types.Object `tfsdk:"logs_unclaimed"`    // AccelByteAmsFleetSamplingRuleModel
+}
+
+// Used by Create, Read and Update operations on AMS Fleets.
+// This copies data from the AccelByte API `fleet` to the TF state `data`.
+func updateFromApiAmsFleet(ctx context.Context, data *AccelByteAmsFleetModel, fleet *amsclientmodels.APIFleetGetResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.FleetId = types.StringValue(*fleet.ID)
+	data.Id = types.StringValue(computeAmsFleetId(data.Namespace.ValueString(), *fleet.ID))
+
+	data.Name = types.StringValue(*fleet.Name)
+	data.Active = types.BoolValue(*fleet.Active)
+	data.OnDemand = types.BoolValue(*fleet.OnDemand)
+
+	// Image deployment profile
+	data.ImageId = types.StringValue(*fleet.ImageDeploymentProfile.ImageID)
+	data.CommandLine = types.StringValue(*fleet.ImageDeploymentProfile.CommandLine)
+
+	ports := []AccelByteAmsFleetPortModel{}
+	for _, port := range fleet.ImageDeploymentProfile.PortConfigurations {
+		ports = append(ports, AccelByteAmsFleetPortModel{
+			Name:     types.StringValue(*port.Name),
+			Protocol: types.StringValue(*port.Protocol),
+		})
+	}
+	portsList, portsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteAmsFleetPortModelAttributeTypes}, ports)
+	data.Ports = portsList
+	diags.Append(portsDiags...)
+
+	timeoutsModel := AccelByteAmsFleetTimeoutsModel{
+		CreationSeconds:     types.Int64Value(0),
+		DrainSeconds:        types.Int64Value(0),
+		SessionSeconds:      types.Int64Value(0),
+		UnresponsiveSeconds: types.Int64Value(0),
+		ClaimSeconds:        types.Int64Value(0),
+	}
+	if fleet.ImageDeploymentProfile.Timeout != nil {
+		timeoutsModel = AccelByteAmsFleetTimeoutsModel{
+			CreationSeconds:     types.Int64Value(fleet.ImageDeploymentProfile.Timeout.Creation),
+			DrainSeconds:        types.Int64Value(fleet.ImageDeploymentProfile.Timeout.Drain),
+			SessionSeconds:      types.Int64Value(fleet.ImageDeploymentProfile.Timeout.Session),
+			UnresponsiveSeconds: types.Int64Value(fleet.ImageDeploymentProfile.Timeout.Unresponsive),
+			ClaimSeconds:        types.Int64Value(fleet.ImageDeploymentProfile.Timeout.Claim),
+		}
+	}
+	timeouts, timeoutsDiags := basetypes.NewObjectValueFrom(ctx, AccelByteAmsFleetTimeoutsModelAttributeTypes, timeoutsModel)
+	data.Timeouts = timeouts
+	diags.Append(timeoutsDiags...)
+
+	// DS host configuration
+	dsHostConfigurationModel := AccelByteAmsFleetDsHostConfigurationModel{
+		InstanceId:   types.StringValue(*fleet.DsHostConfiguration.InstanceID),
+		ServersPerVm: types.Int32Value(*fleet.DsHostConfiguration.ServersPerVM),
+	}
+	dsHostConfiguration, dsHostConfigurationDiags := basetypes.NewObjectValueFrom(ctx, AccelByteAmsFleetDsHostConfigurationModelAttributeTypes, dsHostConfigurationModel)
+	data.DsHostConfiguration = dsHostConfiguration
+	diags.Append(dsHostConfigurationDiags...)
+
+	// Regions
+	regions := []AccelByteAmsFleetRegionModel{}
+	for _, region := range fleet.Regions {
+		regions = append(regions, AccelByteAmsFleetRegionModel{
+			Region:         types.StringValue(*region.Region),
+			BufferSize:     types.Int32Value(*region.BufferSize),
+			DynamicBuffer:  types.BoolValue(*region.DynamicBuffer),
+			MinServerCount: types.Int32Value(*region.MinServerCount),
+			MaxServerCount: types.Int32Value(*region.MaxServerCount),
+		})
+	}
+	regionsList, regionsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteAmsFleetRegionModelAttributeTypes}, regions)
+	data.Regions = regionsList
+	diags.Append(regionsDiags...)
+
+	// Claim keys
+	claimKeys, claimKeysDiags := listValueFromEvenIfNil(ctx, types.StringType, fleet.ClaimKeys)
+	data.ClaimKeys = claimKeys
+	diags.Append(claimKeysDiags...)
+
+	// Sampling rules
+	// The API omits individual rules that have never been configured; these are reflected as "do not collect" rules in the state
+	samplingRulesModel := AccelByteAmsFleetSamplingRulesModel{}
+	var coredumpsCrashed, logsCrashed, logsSuccess, logsUnclaimed *amsclientmodels.APIArtifactSamplingRule
+	if fleet.SamplingRules != nil {
+		if fleet.SamplingRules.Coredumps != nil {
+			coredumpsCrashed = fleet.SamplingRules.Coredumps.Crashed
+		}
+		if fleet.SamplingRules.Logs != nil {
+			logsCrashed = fleet.SamplingRules.Logs.Crashed
+			logsSuccess = fleet.SamplingRules.Logs.Success
+			logsUnclaimed = fleet.SamplingRules.Logs.Unclaimed
+		}
+	}
+	var samplingRuleDiags diag.Diagnostics
+	samplingRulesModel.CoredumpsCrashed, samplingRuleDiags = fromApiAmsFleetSamplingRule(ctx, coredumpsCrashed)
+	diags.Append(samplingRuleDiags...)
+	samplingRulesModel.LogsCrashed, samplingRuleDiags = fromApiAmsFleetSamplingRule(ctx, logsCrashed)
+	diags.Append(samplingRuleDiags...)
+	samplingRulesModel.LogsSuccess, samplingRuleDiags = fromApiAmsFleetSamplingRule(ctx, logsSuccess)
+	diags.Append(samplingRuleDiags...)
+	samplingRulesModel.LogsUnclaimed, samplingRuleDiags = fromApiAmsFleetSamplingRule(ctx, logsUnclaimed)
+	diags.Append(samplingRuleDiags...)
+
+	samplingRules, samplingRulesDiags := basetypes.NewObjectValueFrom(ctx, AccelByteAmsFleetSamplingRulesModelAttributeTypes, samplingRulesModel)
+	data.SamplingRules = samplingRules
+	diags.Append(samplingRulesDiags...)
+
+	return diags
+}
+
+func fromApiAmsFleetSamplingRule(ctx context.Context, rule *amsclientmodels.APIArtifactSamplingRule) (basetypes.ObjectValue, diag.Diagnostics) {
+	ruleModel := AccelByteAmsFleetSamplingRuleModel{
+		Collect:    types.BoolValue(false),
+		Percentage: types.Int64Value(0),
+	}
+	if rule != nil {
+		ruleModel.Collect = types.BoolValue(*rule.Collect)
+		ruleModel.Percentage = types.Int64Value(*rule.Percentage)
+	}
+	return basetypes.NewObjectValueFrom(ctx, AccelByteAmsFleetSamplingRuleModelAttributeTypes, ruleModel)
+}
+
+func toApiAmsFleetSamplingRule(ctx context.Context, rule types.Object) (*amsclientmodels.APIArtifactSamplingRule, diag.Diagnostics) {
+	if rule.IsNull() || rule.IsUnknown() {
+		return nil, nil
+	}
+	var ruleModel AccelByteAmsFleetSamplingRuleModel
+	diags := rule.As(ctx, &ruleModel, basetypes.ObjectAsOptions{})
+	return &amsclientmodels.APIArtifactSamplingRule{
+		Collect:    ruleModel.Collect.ValueBoolPointer(),
+		Percentage: ruleModel.Percentage.ValueInt64Pointer(),
+	}, diags
+}
+
+func toApiAmsFleetTimeout(value types.Int64, currentValue int64) int64 {
+	if value.IsUnknown() || value.IsNull() {
+		return currentValue
+	}
+	return value.ValueInt64()
+}
+
+// Used by the Update operation on AMS Fleets.
+// Reports whether any of the timeouts in the TF plan `data` are still unknown, and must be taken from the current fleet.
+func amsFleetTimeoutsHaveUnknowns(ctx context.Context, data AccelByteAmsFleetModel) bool {
+	if data.Timeouts.IsNull() || data.Timeouts.IsUnknown() {
+		return false
+	}
+	for _, value := range data.Timeouts.Attributes() {
+		if value.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// Used by Create/Update operations on AMS Fleets.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// `currentTimeout` holds the fleet's current timeouts during Update, and is nil during Create.
+func toApiAmsFleetParameters(ctx context.Context, data AccelByteAmsFleetModel, currentTimeout *amsclientmodels.APITimeout) (*amsclientmodels.APIFleetParameters, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	// Image deployment profile
+
+	ports := []AccelByteAmsFleetPortModel{}
+	diags.Append(data.Ports.ElementsAs(ctx, &ports, false)...)
+	apiPorts := []*amsclientmodels.APIPortConfiguration{}
+	for _, port := range ports {
+		apiPorts = append(apiPorts, &amsclientmodels.APIPortConfiguration{
+			Name:     port.Name.ValueStringPointer(),
+			Protocol: port.Protocol.ValueStringPointer(),
+		})
+	}
+
+	// Timeouts that are not known yet keep the fleet's current values, or get defaults from AMS when the fleet is being created
+	var apiTimeout *amsclientmodels.APITimeout = nil
+	if !data.Timeouts.IsNull() && !data.Timeouts.IsUnknown() {
+		var timeoutsModel AccelByteAmsFleetTimeoutsModel
+		diags.Append(data.Timeouts.As(ctx, &timeoutsModel, basetypes.ObjectAsOptions{})...)
+		if currentTimeout == nil {
+			currentTimeout = &amsclientmodels.APITimeout{}
+		}
+		apiTimeout = &amsclientmodels.APITimeout{
+			Creation:     toApiAmsFleetTimeout(timeoutsModel.CreationSeconds, currentTimeout.Creation),
+			Drain:        toApiAmsFleetTimeout(timeoutsModel.DrainSeconds, currentTimeout.Drain),
+			Session:      toApiAmsFleetTimeout(timeoutsModel.SessionSeconds, currentTimeout.Session),
+			Unresponsive: toApiAmsFleetTimeout(timeoutsModel.UnresponsiveSeconds, currentTimeout.Unresponsive),
+			Claim:        toApiAmsFleetTimeout(timeoutsModel.ClaimSeconds, currentTimeout.Claim),
+		}
+	}
+
+	// DS host configuration
+
+	var dsHostConfigurationModel AccelByteAmsFleetDsHostConfigurationModel
+	diags.Append(data.DsHostConfiguration.As(ctx, &dsHostConfigurationModel, basetypes.ObjectAsOptions{})...)
+
+	// Regions
+
+	regions := []AccelByteAmsFleetRegionModel{}
+	diags.Append(data.Regions.ElementsAs(ctx, &regions, false)...)
+	apiRegions := []*amsclientmodels.APIRegionConfig{}
+	for _, region := range regions {
+		apiRegions = append(apiRegions, &amsclientmodels.APIRegionConfig{
+			Region:         region.Region.ValueStringPointer(),
+			BufferSize:     region.BufferSize.ValueInt32Pointer(),
+			DynamicBuffer:  region.DynamicBuffer.ValueBoolPointer(),
+			MinServerCount: region.MinServerCount.ValueInt32Pointer(),
+			MaxServerCount: region.MaxServerCount.ValueInt32Pointer(),
+		})
+	}
+
+	// Claim keys
+
+	claimKeys := make([]string, len(data.ClaimKeys.Elements()))
+	diags.Append(data.ClaimKeys.ElementsAs(ctx, &claimKeys, false)...)
+
+	// Sampling rules
+
+	var apiSamplingRules *amsclientmodels.APIFleetArtifactsSampleRules = nil
+	if !data.SamplingRules.IsNull() && !data.SamplingRules.IsUnknown() {
+		var samplingRulesModel AccelByteAmsFleetSamplingRulesModel
+		diags.Append(data.SamplingRules.As(ctx, &samplingRulesModel, basetypes.ObjectAsOptions{})...)
+
+		coredumpsCrashed, ruleDiags := toApiAmsFleetSamplingRule(ctx, samplingRulesModel.CoredumpsCrashed)
+		diags.Append(ruleDiags...)
+		logsCrashed, ruleDiags := toApiAmsFleetSamplingRule(ctx, samplingRulesModel.LogsCrashed)
+		diags.Append(ruleDiags...)
+		logsSuccess, ruleDiags := toApiAmsFleetSamplingRule(ctx, samplingRulesModel.LogsSuccess)
+		diags.Append(ruleDiags...)
+		logsUnclaimed, ruleDiags := toApiAmsFleetSamplingRule(ctx, samplingRulesModel.LogsUnclaimed)
+		diags.Append(ruleDiags...)
+
+		apiSamplingRules = &amsclientmodels.APIFleetArtifactsSampleRules{
+			Coredumps: &amsclientmodels.APICoredumpSamplingRules{
+				Crashed: coredumpsCrashed,
+			},
+			Logs: &amsclientmodels.APIArtifactTypeSamplingRules{
+				Crashed:   logsCrashed,
+				Success:   logsSuccess,
+				Unclaimed: logsUnclaimed,
+			},
+		}
+	}
+
+	return &amsclientmodels.APIFleetParameters{
+		Name:     data.Name.ValueStringPointer(),
+		Active:   data.Active.ValueBoolPointer(),
+		OnDemand: data.OnDemand.ValueBoolPointer(),
+
+		ImageDeploymentProfile: &amsclientmodels.APIImageDeploymentProfile{
+			ImageID:            data.ImageId.ValueStringPointer(),
+			CommandLine:        data.CommandLine.ValueStringPointer(),
+			PortConfigurations: apiPorts,
+			Timeout:            apiTimeout,
+		},
+
+		DsHostConfiguration: &amsclientmodels.APIDSHostConfigurationParameters{
+			InstanceID:   dsHostConfigurationModel.InstanceId.ValueStringPointer(),
+			ServersPerVM: dsHostConfigurationModel.ServersPerVm.ValueInt32Pointer(),
+		},
+
+		Regions:       apiRegions,
+		ClaimKeys:     claimKeys,
+		SamplingRules: apiSamplingRules,
+	}, diags
+}
+
+func computeAmsFleetId(namespace string, fleetId string) string {
+	return fmt.Sprintf("%s/%s", namespace, fleetId)
+}
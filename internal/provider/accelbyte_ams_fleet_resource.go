@@ -0,0 +1,522 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclient/fleets"
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/ams"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteAmsFleetResource{}
+var _ resource.ResourceWithImportState = &AccelByteAmsFleetResource{}
+
+func NewAccelByteAmsFleetResource() resource.Resource {
+	return &AccelByteAmsFleetResource{}
+}
+
+// AccelByteAmsFleetResource defines the resource implementation.
+type AccelByteAmsFleetResource struct {
+	client *ams.FleetsService
+}
+
+func (r *AccelByteAmsFleetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ams_fleet"
+}
+
+// Schema for a single sampling rule within the `sampling_rules` nested attribute.
+func amsFleetSamplingRuleAttribute(markdownDescription string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: markdownDescription,
+		Attributes: map[string]schema.Attribute{
+			"collect": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, artifacts will be collected for servers that match this rule.",
+				Required:            true,
+			},
+			"percentage": schema.Int64Attribute{
+				MarkdownDescription: "Percentage (0-100) of matching servers for which artifacts will be collected.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+		},
+		Optional: true,
+		Computed: true,
+	}
+}
+
+func (r *AccelByteAmsFleetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an [AMS fleet](https://docs.accelbyte.io/gaming-services/services/ams/fleet-manager/).",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from this and the fleet ID
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the fleet. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "AMS fleet identifier, on the format `{{namespace}}/{{fleet_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fleet_id": schema.StringAttribute{
+				MarkdownDescription: "Fleet ID, as assigned by AMS when the fleet was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of fleet.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"image_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the dedicated server image that servers in this fleet will run. See the `accelbyte_ams_images` data source.",
+				Required:            true,
+			},
+			"ds_host_configuration": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configuration of the virtual machines that will host the dedicated servers.",
+				Attributes: map[string]schema.Attribute{
+					"instance_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the instance type to use for the virtual machines.",
+						Required:            true,
+					},
+					"servers_per_vm": schema.Int32Attribute{
+						MarkdownDescription: "Number of dedicated servers to run on each virtual machine.",
+						Required:            true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+				Required: true,
+			},
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "Regions in which the fleet will run dedicated servers, along with server counts for each region.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Name of the region, for example `us-east-2`.",
+							Required:            true,
+						},
+						"buffer_size": schema.Int32Attribute{
+							MarkdownDescription: "Number of servers that should be kept ready for claiming in this region at all times.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+						"dynamic_buffer": schema.BoolAttribute{
+							MarkdownDescription: "If set to `true`, `buffer_size` is interpreted as a percentage of the number of claimed servers, rather than as an absolute number of servers.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"min_server_count": schema.Int32Attribute{
+							MarkdownDescription: "Minimum number of servers to run in this region.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+						"max_server_count": schema.Int32Attribute{
+							MarkdownDescription: "Maximum number of servers to run in this region.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+					},
+				},
+				Required: true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "If set to `false`, the fleet will not run any servers.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"on_demand": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, servers are only started when they are claimed, instead of being kept ready in a buffer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"command_line": schema.StringAttribute{
+				MarkdownDescription: "Command-line arguments passed to the dedicated server executable.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"ports": schema.ListNestedAttribute{
+				MarkdownDescription: "Ports that each dedicated server will listen on. AMS assigns the actual port numbers when a server starts.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the port.",
+							Required:            true,
+						},
+						"protocol": schema.StringAttribute{
+							MarkdownDescription: "Protocol used on the port. Either `udp` or `tcp`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("udp", "tcp"),
+							},
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default:  listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: AccelByteAmsFleetPortModelAttributeTypes}, []attr.Value{})),
+			},
+			"claim_keys": schema.ListAttribute{
+				MarkdownDescription: "Claim keys that game sessions can use to claim servers from this fleet. Session templates refer to these via `ams_server.preferred_claim_keys` and `ams_server.fallback_claim_keys`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(basetypes.StringType{}, []attr.Value{})),
+			},
+			"server_timeouts": schema.SingleNestedAttribute{
+				MarkdownDescription: "Timeouts for the different phases of a dedicated server's lifetime. Any timeouts that are not specified will get defaults from AMS.",
+				Attributes: map[string]schema.Attribute{
+					"creation_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Maximum time for a server to start up and report that it is ready.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"drain_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Time a server is given to shut down gracefully after it has been asked to drain.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"session_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Maximum lifetime of a server after it has been claimed.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"unresponsive_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Time after which a server that does not respond to heartbeats will be terminated.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"claim_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Maximum time that a server can remain claimed without any players connecting to it.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sampling_rules": schema.SingleNestedAttribute{
+				MarkdownDescription: "Rules for which server artifacts (logs and core dumps) AMS will collect. Any rules that are not specified will not collect artifacts.",
+				Attributes: map[string]schema.Attribute{
+					"coredumps_crashed": amsFleetSamplingRuleAttribute("Collection of core dumps from servers that have crashed."),
+					"logs_crashed":      amsFleetSamplingRuleAttribute("Collection of logs from servers that have crashed."),
+					"logs_success":      amsFleetSamplingRuleAttribute("Collection of logs from servers that have exited successfully."),
+					"logs_unclaimed":    amsFleetSamplingRuleAttribute("Collection of logs from servers that exited without ever being claimed."),
+				},
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteAmsFleetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.AmsFleetsService
+}
+
+func (r *AccelByteAmsFleetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteAmsFleetModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiFleetParameters, apiFleetParametersDiags := toApiAmsFleetParameters(ctx, data, nil)
+	resp.Diagnostics.Append(apiFleetParametersDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating AMS fleet via AccelByte API", map[string]interface{}{
+		"namespace":          data.Namespace,
+		"name":               data.Name.ValueString(),
+		"apiFleetParameters": apiFleetParameters,
+	})
+
+	createInput := &fleets.FleetCreateParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiFleetParameters,
+	}
+
+	createdFleet, err := r.client.FleetCreateShort(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating AMS fleet via AccelByte API", fmt.Sprintf("Unable to create AMS fleet '%s' in namespace '%s', got error: %s", *createInput.Body.Name, createInput.Namespace, err))
+		return
+	}
+
+	// Fetch fleet immediately after creating it, so we can get the values for un-set defaults
+
+	readInput := &fleets.FleetGetParams{
+		Namespace: data.Namespace.ValueString(),
+		FleetID:   *createdFleet.ID,
+	}
+
+	fleet, err := r.client.FleetGetShort(readInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading AMS fleet via AccelByte API", fmt.Sprintf("Unable to read AMS fleet '%s' in namespace '%s', got error: %s", readInput.FleetID, readInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiAmsFleet(ctx, &data, fleet)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteAmsFleetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteAmsFleetModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &fleets.FleetGetParams{
+		Namespace: data.Namespace.ValueString(),
+		FleetID:   data.FleetId.ValueString(),
+	}
+
+	fleet, err := r.client.FleetGetShort(input)
+	if err != nil {
+		notFoundError := &fleets.FleetGetNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading AMS fleet via AccelByte API", fmt.Sprintf("Unable to read AMS fleet '%s' in namespace '%s', got error: %s", input.FleetID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read AMS fleet from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"fleetId":   data.FleetId.ValueString(),
+		"fleet":     fleet,
+	})
+
+	resp.Diagnostics.Append(updateFromApiAmsFleet(ctx, &data, fleet)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteAmsFleetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteAmsFleetModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Timeouts that are neither configured nor known from the prior state must keep their current values; fetch those from the fleet
+	var currentTimeout *amsclientmodels.APITimeout = nil
+	if amsFleetTimeoutsHaveUnknowns(ctx, data) {
+		currentFleet, err := r.client.FleetGetShort(&fleets.FleetGetParams{
+			Namespace: data.Namespace.ValueString(),
+			FleetID:   data.FleetId.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error when reading AMS fleet via AccelByte API", fmt.Sprintf("Unable to read current timeouts of AMS fleet '%s' in namespace '%s', got error: %s", data.FleetId.ValueString(), data.Namespace.ValueString(), err))
+			return
+		}
+		currentTimeout = currentFleet.ImageDeploymentProfile.Timeout
+	}
+
+	apiFleetParameters, apiFleetParametersDiags := toApiAmsFleetParameters(ctx, data, currentTimeout)
+	resp.Diagnostics.Append(apiFleetParametersDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating AMS fleet via AccelByte API", map[string]interface{}{
+		"namespace":          data.Namespace,
+		"fleetId":            data.FleetId.ValueString(),
+		"apiFleetParameters": apiFleetParameters,
+	})
+
+	input := &fleets.FleetUpdateParams{
+		Namespace: data.Namespace.ValueString(),
+		FleetID:   data.FleetId.ValueString(),
+		Body:      apiFleetParameters,
+	}
+
+	err := r.client.FleetUpdateShort(input)
+	if err != nil {
+		notFoundError := &fleets.FleetUpdateNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("AMS fleet '%s' does not exist in namespace '%s'", input.FleetID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating AMS fleet via AccelByte API", fmt.Sprintf("Unable to update AMS fleet '%s' in namespace '%s', got error: %s", input.FleetID, input.Namespace, err))
+			return
+		}
+	}
+
+	// The update API does not return the updated fleet; fetch it, so we can get the values for un-set defaults
+
+	readInput := &fleets.FleetGetParams{
+		Namespace: data.Namespace.ValueString(),
+		FleetID:   data.FleetId.ValueString(),
+	}
+
+	fleet, err := r.client.FleetGetShort(readInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading AMS fleet via AccelByte API", fmt.Sprintf("Unable to read AMS fleet '%s' in namespace '%s', got error: %s", readInput.FleetID, readInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiAmsFleet(ctx, &data, fleet)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteAmsFleetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteAmsFleetModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting AMS fleet via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"fleetId":   data.FleetId.ValueString(),
+	})
+
+	input := &fleets.FleetDeleteParams{
+		Namespace: data.Namespace.ValueString(),
+		FleetID:   data.FleetId.ValueString(),
+	}
+	err := r.client.FleetDeleteShort(input)
+	if err != nil {
+		notFoundError := &fleets.FleetDeleteNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting AMS fleet via AccelByte API", fmt.Sprintf("Unable to delete AMS fleet '%s' in namespace '%s', got error: %s", input.FleetID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteAmsFleetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "fleet_id")
+}
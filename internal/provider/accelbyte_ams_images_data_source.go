@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclient/images"
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/ams"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccelByteAmsImagesDataSource{}
+
+const (
+	// Number of images to request per page when listing AMS images.
+	AMS_IMAGES_PAGE_SIZE = 100
+)
+
+func NewAccelByteAmsImagesDataSource() datasource.DataSource {
+	return &AccelByteAmsImagesDataSource{}
+}
+
+// AccelByteAmsImagesDataSource defines the data source implementation.
+type AccelByteAmsImagesDataSource struct {
+	client *ams.ImagesService
+}
+
+func (d *AccelByteAmsImagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ams_images"
+}
+
+func (d *AccelByteAmsImagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source lists the [dedicated server images](https://docs.accelbyte.io/gaming-services/services/ams/fleet-manager/) that have been uploaded to AMS.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Populated by user
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the images. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "If set, only images whose name contains this string will be listed.",
+				Optional:            true,
+			},
+			"tag": schema.StringAttribute{
+				MarkdownDescription: "If set, only images with this tag will be listed.",
+				Optional:            true,
+			},
+
+			// Computed during Read() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "AMS images identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+			},
+
+			// Fetched from AccelByte API during Read() opearation
+
+			"images": schema.ListNestedAttribute{
+				MarkdownDescription: "Images matching the filters.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Image ID. This is what `accelbyte_ams_fleet.image_id` refers to.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of image.",
+							Computed:            true,
+						},
+						"executable": schema.StringAttribute{
+							MarkdownDescription: "Path to the dedicated server executable within the image.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Upload status of the image, for example `Ready`.",
+							Computed:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "Tags attached to the image.",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"is_protected": schema.BoolAttribute{
+							MarkdownDescription: "If `true`, the image is protected from deletion.",
+							Computed:            true,
+						},
+						"size_in_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Size of the image.",
+							Computed:            true,
+						},
+						"referencing_fleets": schema.Int32Attribute{
+							MarkdownDescription: "Number of fleets which use the image.",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Time when the image was created.",
+							Computed:            true,
+						},
+						"uploaded_at": schema.StringAttribute{
+							MarkdownDescription: "Time when the image upload completed.",
+							Computed:            true,
+						},
+						"uploaded_by": schema.StringAttribute{
+							MarkdownDescription: "User who uploaded the image.",
+							Computed:            true,
+						},
+					},
+				},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *AccelByteAmsImagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AmsImagesService
+}
+
+func (d *AccelByteAmsImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccelByteAmsImagesModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.Namespace
+
+	// Fetch all pages of matching images
+
+	allImages := []*amsclientmodels.APIImageListItem{}
+
+	for {
+		count := int64(AMS_IMAGES_PAGE_SIZE)
+		offset := int64(len(allImages))
+
+		input := &images.ImageListParams{
+			Namespace: data.Namespace.ValueString(),
+			Name:      data.Name.ValueStringPointer(),
+			Tag:       data.Tag.ValueStringPointer(),
+			Count:     &count,
+			Offset:    &offset,
+		}
+
+		imageList, err := d.client.ImageListShort(input)
+		if err != nil {
+			resp.Diagnostics.AddError("Error when reading AMS images via AccelByte API", fmt.Sprintf("Unable to list AMS images in namespace '%s', got error: %s", input.Namespace, err))
+			return
+		}
+
+		allImages = append(allImages, imageList.Images...)
+
+		if len(imageList.Images) < AMS_IMAGES_PAGE_SIZE || imageList.Paging == nil || imageList.Paging.HasNext == nil || !*imageList.Paging.HasNext {
+			break
+		}
+	}
+
+	tflog.Trace(ctx, "Read AMS images from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"images":    allImages,
+	})
+
+	resp.Diagnostics.Append(updateFromApiAmsImages(ctx, &data, allImages)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteAmsImagesModel is used by AccelByteAmsImagesDataSource.
+type AccelByteAmsImagesModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Optional filters, populated by user
+	Name types.String `tfsdk:"name"`
+	Tag  types.String `tfsdk:"tag"`
+
+	// Computed during Read() operation
+	Id types.String `tfsdk:"id"`
+
+	// Fetched from AccelByte API during Read() operation
+	Images types.List `tfsdk:"images"` // []AccelByteAmsImageModel
+}
+
+var AccelByteAmsImageModelAttributeTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"name":               types.StringType,
+	"executable":         types.StringType,
+	"status":             types.StringType,
+	"tags":               types.ListType{}.WithElementType(types.StringType),
+	"is_protected":       types.BoolType,
+	"size_in_bytes":      types.Int64Type,
+	"referencing_fleets": types.Int32Type,
+	"created_at":         types.StringType,
+	"uploaded_at":        types.StringType,
+	"uploaded_by":        types.StringType,
+}
+
+type AccelByteAmsImageModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Executable        types.String `tfsdk:"executable"`
+	Status            types.String `tfsdk:"status"`
+	Tags              types.List   `tfsdk:"tags"`
+	IsProtected       types.Bool   `tfsdk:"is_protected"`
+	SizeInBytes       types.Int64  `tfsdk:"size_in_bytes"`
+	ReferencingFleets types.Int32  `tfsdk:"referencing_fleets"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	UploadedAt        types.String `tfsdk:"uploaded_at"`
+	UploadedBy        types.String `tfsdk:"uploaded_by"`
+}
+
+// Used by Read operations on AMS Images.
+// This copies data from the AccelByte API `images` to the TF state `data`.
+func updateFromApiAmsImages(ctx context.Context, data *AccelByteAmsImagesModel, images []*amsclientmodels.APIImageListItem) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	imageModels := []AccelByteAmsImageModel{}
+	for _, image := range images {
+		tags, tagsDiags := listValueFromEvenIfNil(ctx, types.StringType, image.Tags)
+		diags.Append(tagsDiags...)
+
+		imageModels = append(imageModels, AccelByteAmsImageModel{
+			Id:                types.StringPointerValue(image.ID),
+			Name:              types.StringPointerValue(image.Name),
+			Executable:        types.StringPointerValue(image.Executable),
+			Status:            types.StringPointerValue(image.Status),
+			Tags:              tags,
+			IsProtected:       types.BoolPointerValue(image.IsProtected),
+			SizeInBytes:       types.Int64PointerValue(image.SizeInByte),
+			ReferencingFleets: types.Int32PointerValue(image.ReferencingFleets),
+			CreatedAt:         types.StringPointerValue(image.CreatedAt),
+			UploadedAt:        types.StringPointerValue(image.UploadedAt),
+			UploadedBy:        types.StringPointerValue(image.UploadedBy),
+		})
+	}
+
+	imagesList, imagesDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteAmsImageModelAttributeTypes}, imageModels)
+	data.Images = imagesList
+	diags.Append(imagesDiags...)
+
+	return diags
+}
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclient/a_m_s_info"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/ams"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AccelByteAmsRegionsDataSource{}
+
+func NewAccelByteAmsRegionsDataSource() datasource.DataSource {
+	return &AccelByteAmsRegionsDataSource{}
+}
+
+// AccelByteAmsRegionsDataSource defines the data source implementation.
+type AccelByteAmsRegionsDataSource struct {
+	client *ams.AMSInfoService
+}
+
+func (d *AccelByteAmsRegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ams_regions"
+}
+
+func (d *AccelByteAmsRegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This data source lists the [AMS regions](https://docs.accelbyte.io/gaming-services/services/ams/fleet-manager/) that are available to the account which owns a namespace.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Populated by user
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+			},
+
+			// Computed during Read() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "AMS regions identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+			},
+
+			// Fetched from AccelByte API during Read() opearation
+
+			"regions": schema.ListAttribute{
+				MarkdownDescription: "Names of all regions in which AMS can run dedicated servers, for example `us-east-2`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AccelByteAmsRegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = clients.AmsInfoService
+}
+
+func (d *AccelByteAmsRegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data AccelByteAmsRegionsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.Namespace
+
+	input := &a_m_s_info.InfoRegionsParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+
+	regions, err := d.client.InfoRegionsShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading AMS regions via AccelByte API", fmt.Sprintf("Unable to read AMS regions in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	tflog.Trace(ctx, "Read AMS regions from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"regions":   regions,
+	})
+
+	resp.Diagnostics.Append(updateFromApiAmsRegions(ctx, &data, regions)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteAmsRegionsModel is used by AccelByteAmsRegionsDataSource.
+type AccelByteAmsRegionsModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Read() operation
+	Id types.String `tfsdk:"id"`
+
+	// Fetched from AccelByte API during Read() operation
+	Regions types.List `tfsdk:"regions"`
+}
+
+// Used by Read operations on AMS Regions.
+// This copies data from the AccelByte API `regions` to the TF state `data`.
+func updateFromApiAmsRegions(ctx context.Context, data *AccelByteAmsRegionsModel, regions *amsclientmodels.APIAMSRegionsResponse) diag.Diagnostics {
+
+	regionsList, diags := listValueFromEvenIfNil(ctx, types.StringType, regions.Regions)
+	data.Regions = regionsList
+
+	return diags
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/AccelByte/accelbyte-go-sdk/chat-sdk/pkg/chatclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteChatConfigurationModel is used by AccelByteChatConfigurationResource.
+type AccelByteChatConfigurationModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Can be set by user during resource creation; will otherwise retain the current settings in the AccelByte backend
+	ChatRateLimitBurst        types.Int32  `tfsdk:"chat_rate_limit_burst"`
+	ChatRateLimitDuration     types.Int64  `tfsdk:"chat_rate_limit_duration"`
+	ConcurrentUsersLimit      types.Int32  `tfsdk:"concurrent_users_limit"`
+	EnableClanChat            types.Bool   `tfsdk:"enable_clan_chat"`
+	EnableManualTopicCreation types.Bool   `tfsdk:"enable_manual_topic_creation"`
+	EnableProfanityFilter     types.Bool   `tfsdk:"enable_profanity_filter"`
+	FilterAppName             types.String `tfsdk:"filter_app_name"`
+	FilterParam               types.String `tfsdk:"filter_param"`
+	FilterType                types.String `tfsdk:"filter_type"`
+	GeneralRateLimitBurst     types.Int32  `tfsdk:"general_rate_limit_burst"`
+	GeneralRateLimitDuration  types.Int64  `tfsdk:"general_rate_limit_duration"`
+	MaxChatMessageLength      types.Int32  `tfsdk:"max_chat_message_length"`
+	ShardCapacityLimit        types.Int32  `tfsdk:"shard_capacity_limit"`
+	ShardDefaultLimit         types.Int32  `tfsdk:"shard_default_limit"`
+	ShardHardLimit            types.Int32  `tfsdk:"shard_hard_limit"`
+	SpamChatBurst             types.Int32  `tfsdk:"spam_chat_burst"`
+	SpamChatDuration          types.Int64  `tfsdk:"spam_chat_duration"`
+	SpamMuteDuration          types.Int64  `tfsdk:"spam_mute_duration"`
+}
+
+// Used by Create, Read and Update operations on the chat configuration.
+// This copies data from the AccelByte API `config` to the TF state `data`.
+func updateFromApiChatConfiguration(data *AccelByteChatConfigurationModel, config *chatclientmodels.ModelsConfigResponse) {
+
+	data.Id = types.StringValue(data.Namespace.ValueString())
+
+	data.ChatRateLimitBurst = types.Int32Value(config.ChatRateLimitBurst)
+	data.ChatRateLimitDuration = types.Int64Value(config.ChatRateLimitDuration)
+	data.ConcurrentUsersLimit = types.Int32Value(config.ConcurrentUsersLimit)
+	data.EnableClanChat = types.BoolValue(config.EnableClanChat)
+	data.EnableManualTopicCreation = types.BoolValue(config.EnableManualTopicCreation)
+	data.EnableProfanityFilter = types.BoolValue(config.EnableProfanityFilter)
+	data.FilterAppName = types.StringValue(config.FilterAppName)
+	data.FilterParam = types.StringValue(config.FilterParam)
+	data.FilterType = types.StringValue(config.FilterType)
+	data.GeneralRateLimitBurst = types.Int32Value(config.GeneralRateLimitBurst)
+	data.GeneralRateLimitDuration = types.Int64Value(config.GeneralRateLimitDuration)
+	data.MaxChatMessageLength = types.Int32Value(config.MaxChatMessageLength)
+	data.ShardCapacityLimit = types.Int32Value(config.ShardCapacityLimit)
+	data.ShardDefaultLimit = types.Int32Value(config.ShardDefaultLimit)
+	data.ShardHardLimit = types.Int32Value(config.ShardHardLimit)
+	data.SpamChatBurst = types.Int32Value(config.SpamChatBurst)
+	data.SpamChatDuration = types.Int64Value(config.SpamChatDuration)
+	data.SpamMuteDuration = types.Int64Value(config.SpamMuteDuration)
+}
+
+// Used by Create/Update operations on the chat configuration.
+// This reads from the TF plan `data` and returns an AccelByte API object.
+// The API replaces the entire configuration, so settings which are not known in the plan are copied from `current`.
+func toApiChatConfiguration(data AccelByteChatConfigurationModel, current *chatclientmodels.ModelsConfigResponse) *chatclientmodels.ModelsConfigResponse {
+
+	config := *current
+
+	if !data.ChatRateLimitBurst.IsNull() && !data.ChatRateLimitBurst.IsUnknown() {
+		config.ChatRateLimitBurst = data.ChatRateLimitBurst.ValueInt32()
+	}
+	if !data.ChatRateLimitDuration.IsNull() && !data.ChatRateLimitDuration.IsUnknown() {
+		config.ChatRateLimitDuration = data.ChatRateLimitDuration.ValueInt64()
+	}
+	if !data.ConcurrentUsersLimit.IsNull() && !data.ConcurrentUsersLimit.IsUnknown() {
+		config.ConcurrentUsersLimit = data.ConcurrentUsersLimit.ValueInt32()
+	}
+	if !data.EnableClanChat.IsNull() && !data.EnableClanChat.IsUnknown() {
+		config.EnableClanChat = data.EnableClanChat.ValueBool()
+	}
+	if !data.EnableManualTopicCreation.IsNull() && !data.EnableManualTopicCreation.IsUnknown() {
+		config.EnableManualTopicCreation = data.EnableManualTopicCreation.ValueBool()
+	}
+	if !data.EnableProfanityFilter.IsNull() && !data.EnableProfanityFilter.IsUnknown() {
+		config.EnableProfanityFilter = data.EnableProfanityFilter.ValueBool()
+	}
+	if !data.FilterAppName.IsNull() && !data.FilterAppName.IsUnknown() {
+		config.FilterAppName = data.FilterAppName.ValueString()
+	}
+	if !data.FilterParam.IsNull() && !data.FilterParam.IsUnknown() {
+		config.FilterParam = data.FilterParam.ValueString()
+	}
+	if !data.FilterType.IsNull() && !data.FilterType.IsUnknown() {
+		config.FilterType = data.FilterType.ValueString()
+	}
+	if !data.GeneralRateLimitBurst.IsNull() && !data.GeneralRateLimitBurst.IsUnknown() {
+		config.GeneralRateLimitBurst = data.GeneralRateLimitBurst.ValueInt32()
+	}
+	if !data.GeneralRateLimitDuration.IsNull() && !data.GeneralRateLimitDuration.IsUnknown() {
+		config.GeneralRateLimitDuration = data.GeneralRateLimitDuration.ValueInt64()
+	}
+	if !data.MaxChatMessageLength.IsNull() && !data.MaxChatMessageLength.IsUnknown() {
+		config.MaxChatMessageLength = data.MaxChatMessageLength.ValueInt32()
+	}
+	if !data.ShardCapacityLimit.IsNull() && !data.ShardCapacityLimit.IsUnknown() {
+		config.ShardCapacityLimit = data.ShardCapacityLimit.ValueInt32()
+	}
+	if !data.ShardDefaultLimit.IsNull() && !data.ShardDefaultLimit.IsUnknown() {
+		config.ShardDefaultLimit = data.ShardDefaultLimit.ValueInt32()
+	}
+	if !data.ShardHardLimit.IsNull() && !data.ShardHardLimit.IsUnknown() {
+		config.ShardHardLimit = data.ShardHardLimit.ValueInt32()
+	}
+	if !data.SpamChatBurst.IsNull() && !data.SpamChatBurst.IsUnknown() {
+		config.SpamChatBurst = data.SpamChatBurst.ValueInt32()
+	}
+	if !data.SpamChatDuration.IsNull() && !data.SpamChatDuration.IsUnknown() {
+		config.SpamChatDuration = data.SpamChatDuration.ValueInt64()
+	}
+	if !data.SpamMuteDuration.IsNull() && !data.SpamMuteDuration.IsUnknown() {
+		config.SpamMuteDuration = data.SpamMuteDuration.ValueInt64()
+	}
+
+	return &config
+}
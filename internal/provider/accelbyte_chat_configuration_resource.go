@@ -0,0 +1,428 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/chat-sdk/pkg/chatclient/config"
+	"github.com/AccelByte/accelbyte-go-sdk/chat-sdk/pkg/chatclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/chat"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteChatConfigurationResource{}
+var _ resource.ResourceWithImportState = &AccelByteChatConfigurationResource{}
+
+func NewAccelByteChatConfigurationResource() resource.Resource {
+	return &AccelByteChatConfigurationResource{}
+}
+
+// AccelByteChatConfigurationResource defines the resource implementation.
+type AccelByteChatConfigurationResource struct {
+	client *chat.ConfigService
+}
+
+func (r *AccelByteChatConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chat_configuration"
+}
+
+func (r *AccelByteChatConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the chat configuration of a namespace. This controls the chat behaviour behind the `chat_room` setting of `accelbyte_session_template`. There is exactly one chat configuration per namespace; use at most one of these resources per namespace.\n\nSettings which are not set retain their current values in the AccelByte backend. The chat configuration cannot be deleted; destroying the resource leaves the current settings in place.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace whose chat is configured. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Chat configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise retain the current settings in the AccelByte backend
+
+			"chat_rate_limit_burst": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of chat messages that a player can send within `chat_rate_limit_duration`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"chat_rate_limit_duration": schema.Int64Attribute{
+				MarkdownDescription: "Duration of the chat rate limit window.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"concurrent_users_limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of players who can be connected to chat at the same time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"enable_clan_chat": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, chat is enabled for groups.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_manual_topic_creation": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players can create chat topics themselves.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_profanity_filter": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, chat messages are run through the profanity filter. The words which are filtered are managed with `accelbyte_chat_profanity_dictionary`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter_app_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the app which provides a custom profanity filter, if `filter_type` uses one.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter_param": schema.StringAttribute{
+				MarkdownDescription: "Additional parameter which is passed to the profanity filter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the profanity filter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"general_rate_limit_burst": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of chat requests, other than chat messages, that a player can send within `general_rate_limit_duration`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"general_rate_limit_duration": schema.Int64Attribute{
+				MarkdownDescription: "Duration of the general rate limit window.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_chat_message_length": schema.Int32Attribute{
+				MarkdownDescription: "Maximum length of a chat message, in characters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"shard_capacity_limit": schema.Int32Attribute{
+				MarkdownDescription: "Capacity limit of each chat topic shard.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"shard_default_limit": schema.Int32Attribute{
+				MarkdownDescription: "Default number of players per chat topic shard.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"shard_hard_limit": schema.Int32Attribute{
+				MarkdownDescription: "Hard limit on the number of players per chat topic shard.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"spam_chat_burst": schema.Int32Attribute{
+				MarkdownDescription: "Number of chat messages within `spam_chat_duration` after which a player is considered to be spamming, and is muted for `spam_mute_duration`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"spam_chat_duration": schema.Int64Attribute{
+				MarkdownDescription: "Duration of the spam detection window.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"spam_mute_duration": schema.Int64Attribute{
+				MarkdownDescription: "Duration for which a player who is spamming is muted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteChatConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.ChatConfigService
+}
+
+func (r *AccelByteChatConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteChatConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The chat configuration always exists; creating the resource updates it
+	// Fetch the current configuration first, so that settings which are not set by the user retain their current values
+
+	getInput := &config.AdminGetConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+	}
+
+	current, err := r.client.AdminGetConfigV1Short(getInput)
+	if err != nil {
+		notFoundError := &config.AdminGetConfigV1NotFound{}
+		if !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Error when reading chat configuration via AccelByte API", fmt.Sprintf("Unable to read chat configuration in namespace '%s', got error: %s", getInput.Namespace, err))
+			return
+		}
+		// The namespace has no chat configuration yet; start from the service's defaults
+		current = &chatclientmodels.ModelsConfigResponse{}
+	}
+
+	tflog.Trace(ctx, "Creating chat configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &config.AdminUpdateConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiChatConfiguration(data, current),
+	}
+
+	chatConfig, err := r.client.AdminUpdateConfigV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating chat configuration via AccelByte API", fmt.Sprintf("Unable to create chat configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	updateFromApiChatConfiguration(&data, chatConfig)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteChatConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteChatConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &config.AdminGetConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+	}
+
+	chatConfig, err := r.client.AdminGetConfigV1Short(input)
+	if err != nil {
+		notFoundError := &config.AdminGetConfigV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading chat configuration via AccelByte API", fmt.Sprintf("Unable to read chat configuration in namespace '%s', got error: %s", input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read chat configuration from AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"chatConfig": chatConfig,
+	})
+
+	updateFromApiChatConfiguration(&data, chatConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteChatConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteChatConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating chat configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	// All settings are known in the plan, since unset settings retain their values from the TF state
+	input := &config.AdminUpdateConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiChatConfiguration(data, &chatclientmodels.ModelsConfigResponse{}),
+	}
+
+	chatConfig, err := r.client.AdminUpdateConfigV1Short(input)
+	if err != nil {
+		notFoundError := &config.AdminUpdateConfigV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Chat configuration does not exist in namespace '%s'", input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating chat configuration via AccelByte API", fmt.Sprintf("Unable to update chat configuration in namespace '%s', got error: %s", input.Namespace, err))
+			return
+		}
+	}
+
+	updateFromApiChatConfiguration(&data, chatConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteChatConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteChatConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The chat configuration cannot be deleted; the current settings are left in place
+	tflog.Trace(ctx, "Removing chat configuration from Terraform state; settings are left in place", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+}
+
+func (r *AccelByteChatConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/AccelByte/accelbyte-go-sdk/chat-sdk/pkg/chatclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteChatProfanityDictionaryModel is used by AccelByteChatProfanityDictionaryResource.
+type AccelByteChatProfanityDictionaryModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Words types.Set `tfsdk:"words"` // []AccelByteChatProfanityWordModel
+}
+
+var AccelByteChatProfanityWordModelAttributeTypes = map[string]attr.Type{
+	"word":            types.StringType,
+	"word_type":       types.StringType,
+	"false_positives": types.SetType{ElemType: types.StringType},
+	"false_negatives": types.SetType{ElemType: types.StringType},
+}
+
+type AccelByteChatProfanityWordModel struct {
+	Word           types.String `tfsdk:"word"`
+	WordType       types.String `tfsdk:"word_type"`
+	FalsePositives types.Set    `tfsdk:"false_positives"` // []string
+	FalseNegatives types.Set    `tfsdk:"false_negatives"` // []string
+}
+
+// Used by Create, Read and Update operations on the profanity dictionary.
+// This copies data from the AccelByte API `words` to the TF state `data`.
+func updateFromApiChatProfanityDictionary(ctx context.Context, data *AccelByteChatProfanityDictionaryModel, words []*chatclientmodels.ModelsDictionaryWithChildren) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Id = types.StringValue(data.Namespace.ValueString())
+
+	wordModels := []AccelByteChatProfanityWordModel{}
+	for _, word := range words {
+		falsePositives, falsePositivesDiags := types.SetValueFrom(ctx, types.StringType, chatProfanityChildWords(word.FalsePositives))
+		diags.Append(falsePositivesDiags...)
+		falseNegatives, falseNegativesDiags := types.SetValueFrom(ctx, types.StringType, chatProfanityChildWords(word.FalseNegatives))
+		diags.Append(falseNegativesDiags...)
+
+		wordModels = append(wordModels, AccelByteChatProfanityWordModel{
+			Word:           types.StringPointerValue(word.Word),
+			WordType:       types.StringPointerValue(word.WordType),
+			FalsePositives: falsePositives,
+			FalseNegatives: falseNegatives,
+		})
+	}
+
+	wordsSet, wordsDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteChatProfanityWordModelAttributeTypes}, wordModels)
+	data.Words = wordsSet
+	diags.Append(wordsDiags...)
+
+	return diags
+}
+
+// Used by Create and Update operations on the profanity dictionary.
+// This reads from the TF state `data` and returns the AccelByte API words.
+func toApiChatProfanityDictionary(ctx context.Context, data AccelByteChatProfanityDictionaryModel) ([]*chatclientmodels.ModelsDictionaryInsertRequest, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	wordModels := []AccelByteChatProfanityWordModel{}
+	diags.Append(data.Words.ElementsAs(ctx, &wordModels, false)...)
+
+	words := []*chatclientmodels.ModelsDictionaryInsertRequest{}
+	for _, wordModel := range wordModels {
+		falsePositives := []string{}
+		diags.Append(wordModel.FalsePositives.ElementsAs(ctx, &falsePositives, false)...)
+		falseNegatives := []string{}
+		diags.Append(wordModel.FalseNegatives.ElementsAs(ctx, &falseNegatives, false)...)
+
+		words = append(words, &chatclientmodels.ModelsDictionaryInsertRequest{
+			Word:          wordModel.Word.ValueStringPointer(),
+			WordType:      wordModel.WordType.ValueStringPointer(),
+			FalsePositive: falsePositives,
+			FalseNegative: falseNegatives,
+		})
+	}
+
+	return words, diags
+}
+
+// Returns the words of the false positives or false negatives of a dictionary entry.
+func chatProfanityChildWords(children []*chatclientmodels.ModelsDictionaryChild) []string {
+	words := []string{}
+	for _, child := range children {
+		words = append(words, *child.Word)
+	}
+	return words
+}
+
+// Returns true if the dictionary entry `current` differs from `desired` in anything but its word.
+// False positives and false negatives are compared regardless of order.
+func chatProfanityWordChanged(desired *chatclientmodels.ModelsDictionaryInsertRequest, current *chatclientmodels.ModelsDictionaryWithChildren) bool {
+	if *desired.WordType != *current.WordType {
+		return true
+	}
+
+	sortedEqual := func(a []string, b []string) bool {
+		a = slices.Clone(a)
+		b = slices.Clone(b)
+		slices.Sort(a)
+		slices.Sort(b)
+		return slices.Equal(a, b)
+	}
+
+	return !sortedEqual(desired.FalsePositive, chatProfanityChildWords(current.FalsePositives)) ||
+		!sortedEqual(desired.FalseNegative, chatProfanityChildWords(current.FalseNegatives))
+}
@@ -0,0 +1,418 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/chat-sdk/pkg/chatclient/profanity"
+	"github.com/AccelByte/accelbyte-go-sdk/chat-sdk/pkg/chatclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/chat"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteChatProfanityDictionaryResource{}
+var _ resource.ResourceWithImportState = &AccelByteChatProfanityDictionaryResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteChatProfanityDictionaryResource{}
+
+const chatProfanityDictionaryPageSize = 100
+const chatProfanityDictionaryBulkSize = 500
+
+func NewAccelByteChatProfanityDictionaryResource() resource.Resource {
+	return &AccelByteChatProfanityDictionaryResource{}
+}
+
+// AccelByteChatProfanityDictionaryResource defines the resource implementation.
+type AccelByteChatProfanityDictionaryResource struct {
+	client *chat.ProfanityService
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_chat_profanity_dictionary"
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the profanity filter dictionary of a namespace, which the Chat service uses when `enable_profanity_filter` is set in `accelbyte_chat_configuration`. There is exactly one dictionary per namespace; use at most one of these resources per namespace.\n\nThis resource manages all words in the dictionary. Words which are not listed in `words` are deleted. Changes are applied as a diff: new words are added in bulk, and only changed or removed words are updated or deleted individually.\n\nDestroying the resource deletes all words from the dictionary.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace whose profanity dictionary is managed. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Profanity dictionary identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"words": schema.SetNestedAttribute{
+				MarkdownDescription: "Words which the profanity filter matches. Each word can be listed at most once.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"word": schema.StringAttribute{
+							MarkdownDescription: "Word which the profanity filter matches.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"word_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the word, used to group words in the dictionary.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("PROFANITY"),
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"false_positives": schema.SetAttribute{
+							MarkdownDescription: "Words which contain `word` but should not be filtered, for example `class` for the word `ass`.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+							Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+						"false_negatives": schema.SetAttribute{
+							MarkdownDescription: "Variants of `word` which the filter would otherwise miss, and which should be filtered as well.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+							Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default:  setdefault.StaticValue(basetypes.NewSetValueMust(types.ObjectType{AttrTypes: AccelByteChatProfanityWordModelAttributeTypes}, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteChatProfanityDictionaryModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Words.IsNull() || data.Words.IsUnknown() {
+		return
+	}
+
+	wordModels := []AccelByteChatProfanityWordModel{}
+	resp.Diagnostics.Append(data.Words.ElementsAs(ctx, &wordModels, false)...)
+
+	words := map[string]bool{}
+	for _, wordModel := range wordModels {
+		if wordModel.Word.IsUnknown() {
+			continue
+		}
+		word := wordModel.Word.ValueString()
+		if words[word] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("words"),
+				"Duplicate word",
+				fmt.Sprintf("Attribute `words` contains more than one entry for word '%s'", word),
+			)
+		}
+		words[word] = true
+	}
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.ChatProfanityService
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteChatProfanityDictionaryModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating profanity dictionary via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	// The dictionary always exists; creating the resource replaces its contents
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteChatProfanityDictionaryModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The dictionary always exists, so there is no not-found case here
+	words, err := r.listWords(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading profanity dictionary via AccelByte API", fmt.Sprintf("Unable to read profanity dictionary in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "Read profanity dictionary from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"wordCount": len(words),
+	})
+
+	resp.Diagnostics.Append(updateFromApiChatProfanityDictionary(ctx, &data, words)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteChatProfanityDictionaryModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating profanity dictionary via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteChatProfanityDictionaryModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting profanity dictionary via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	// The words in the dictionary are all owned by this resource, so remove every one of them
+	currentWords, err := r.listWords(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading profanity dictionary via AccelByte API", fmt.Sprintf("Unable to read profanity dictionary in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyWords(data.Namespace.ValueString(), []*chatclientmodels.ModelsDictionaryInsertRequest{}, currentWords)...)
+}
+
+func (r *AccelByteChatProfanityDictionaryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
+
+// Change the dictionary so that it matches the TF plan `data`, then refresh `data` from the AccelByte API
+func (r *AccelByteChatProfanityDictionaryResource) write(ctx context.Context, data *AccelByteChatProfanityDictionaryModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	words, wordsDiags := toApiChatProfanityDictionary(ctx, *data)
+	diags.Append(wordsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	currentWords, err := r.listWords(data.Namespace.ValueString())
+	if err != nil {
+		diags.AddError("Error when reading profanity dictionary via AccelByte API", fmt.Sprintf("Unable to read profanity dictionary in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return diags
+	}
+
+	diags.Append(r.applyWords(data.Namespace.ValueString(), words, currentWords)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Adding words in bulk does not return the words, so fetch the dictionary again
+	updatedWords, err := r.listWords(data.Namespace.ValueString())
+	if err != nil {
+		diags.AddError("Error when reading profanity dictionary via AccelByte API", fmt.Sprintf("Unable to read profanity dictionary in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return diags
+	}
+
+	diags.Append(updateFromApiChatProfanityDictionary(ctx, data, updatedWords)...)
+
+	return diags
+}
+
+// Fetch all words in the dictionary, including their false positives and false negatives, one page at a time
+func (r *AccelByteChatProfanityDictionaryResource) listWords(namespace string) ([]*chatclientmodels.ModelsDictionaryWithChildren, error) {
+
+	words := []*chatclientmodels.ModelsDictionaryWithChildren{}
+	includeChildren := true
+	limit := int64(chatProfanityDictionaryPageSize)
+	for offset := int64(0); ; offset += limit {
+		offset := offset
+		page, err := r.client.AdminProfanityQueryShort(&profanity.AdminProfanityQueryParams{
+			Namespace:       namespace,
+			IncludeChildren: &includeChildren,
+			Limit:           &limit,
+			Offset:          &offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, word := range page.Data {
+			// False positives and false negatives are returned as children of their word
+			if word.ParentID == "" {
+				words = append(words, word)
+			}
+		}
+
+		if len(page.Data) < int(limit) {
+			return words, nil
+		}
+	}
+}
+
+// Delete, update and add words so that the dictionary matches `desiredWords`
+// New words are added in bulk; changed and removed words are handled one at a time
+func (r *AccelByteChatProfanityDictionaryResource) applyWords(namespace string, desiredWords []*chatclientmodels.ModelsDictionaryInsertRequest, currentWords []*chatclientmodels.ModelsDictionaryWithChildren) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	currentWordsByWord := map[string]*chatclientmodels.ModelsDictionaryWithChildren{}
+	for _, currentWord := range currentWords {
+		currentWordsByWord[*currentWord.Word] = currentWord
+	}
+
+	desiredWordsByWord := map[string]bool{}
+	for _, desiredWord := range desiredWords {
+		desiredWordsByWord[*desiredWord.Word] = true
+	}
+
+	for _, currentWord := range currentWords {
+		if desiredWordsByWord[*currentWord.Word] {
+			continue
+		}
+		err := r.client.AdminProfanityDeleteShort(&profanity.AdminProfanityDeleteParams{
+			Namespace: namespace,
+			ID:        *currentWord.ID,
+		})
+		if err != nil {
+			diags.AddError("Error when deleting profanity word via AccelByte API", fmt.Sprintf("Unable to delete word '%s' from profanity dictionary in namespace '%s', got error: %s", *currentWord.Word, namespace, err))
+			return diags
+		}
+	}
+
+	newWords := []*chatclientmodels.ModelsDictionaryInsertRequest{}
+	for _, desiredWord := range desiredWords {
+		currentWord, ok := currentWordsByWord[*desiredWord.Word]
+		if !ok {
+			newWords = append(newWords, desiredWord)
+			continue
+		}
+		if !chatProfanityWordChanged(desiredWord, currentWord) {
+			continue
+		}
+		_, err := r.client.AdminProfanityUpdateShort(&profanity.AdminProfanityUpdateParams{
+			Namespace: namespace,
+			ID:        *currentWord.ID,
+			Body: &chatclientmodels.ModelsDictionaryUpdateRequest{
+				Word:          desiredWord.Word,
+				WordType:      desiredWord.WordType,
+				FalsePositive: desiredWord.FalsePositive,
+				FalseNegative: desiredWord.FalseNegative,
+			},
+		})
+		if err != nil {
+			diags.AddError("Error when updating profanity word via AccelByte API", fmt.Sprintf("Unable to update word '%s' in profanity dictionary in namespace '%s', got error: %s", *desiredWord.Word, namespace, err))
+			return diags
+		}
+	}
+
+	for start := 0; start < len(newWords); start += chatProfanityDictionaryBulkSize {
+		end := min(start+chatProfanityDictionaryBulkSize, len(newWords))
+		_, err := r.client.AdminProfanityCreateBulkShort(&profanity.AdminProfanityCreateBulkParams{
+			Namespace: namespace,
+			Body: &chatclientmodels.ModelsDictionaryInsertBulkRequest{
+				Dictionaries: newWords[start:end],
+			},
+		})
+		if err != nil {
+			diags.AddError("Error when adding profanity words via AccelByte API", fmt.Sprintf("Unable to add %d words to profanity dictionary in namespace '%s', got error: %s", end-start, namespace, err))
+			return diags
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclientmodels"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Name of the field in CloudSave request bodies which holds record metadata, such as tags and TTL configuration.
+const CLOUDSAVE_RECORD_METADATA_FIELD = "__META"
+
+// AccelByteCloudsaveAdminGameRecordModel is used by AccelByteCloudsaveAdminGameRecordResource.
+type AccelByteCloudsaveAdminGameRecordModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	Key       types.String `tfsdk:"key"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	// The value is compared semantically, so that whitespace and key ordering differences between
+	// the user's JSON and the JSON returned by the API do not result in a diff
+	Value jsontypes.Normalized `tfsdk:"value"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Tags      types.Set    `tfsdk:"tags"`       // []string
+	TtlConfig types.Object `tfsdk:"ttl_config"` // AccelByteCloudsaveTtlConfigModel
+	Overwrite types.Bool   `tfsdk:"overwrite"`
+}
+
+var AccelByteCloudsaveTtlConfigModelAttributeTypes = map[string]attr.Type{
+	"action":     types.StringType,
+	"expires_at": types.StringType,
+}
+
+type AccelByteCloudsaveTtlConfigModel struct {
+	Action    types.String `tfsdk:"action"`
+	ExpiresAt types.String `tfsdk:"expires_at"`
+}
+
+// Used by Create, Read and Update operations on admin game records.
+// This copies data from the AccelByte API `record` to the TF state `data`.
+func updateFromApiCloudsaveAdminGameRecord(ctx context.Context, data *AccelByteCloudsaveAdminGameRecordModel, record *cloudsaveclientmodels.ModelsAdminGameRecordResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*record.Namespace)
+	data.Key = types.StringValue(*record.Key)
+	data.Id = types.StringValue(computeCloudsaveAdminGameRecordId(*record.Namespace, *record.Key))
+
+	value, err := json.Marshal(record.Value)
+	if err != nil {
+		diags.AddError("Unable to convert API's admin game record value to JSON", fmt.Sprintf("Unable to convert value of admin game record '%s' to JSON, got error: %s", *record.Key, err))
+		return diags
+	}
+	data.Value = jsontypes.NewNormalizedValue(string(value))
+
+	tags, tagsDiags := setValueFromEvenIfNil(ctx, types.StringType, record.Tags)
+	data.Tags = tags
+	diags.Append(tagsDiags...)
+
+	ttlConfig, ttlConfigDiags := fromApiCloudsaveTtlConfig(ctx, data.TtlConfig, record.TTLConfig)
+	data.TtlConfig = ttlConfig
+	diags.Append(ttlConfigDiags...)
+
+	// The overwrite setting only affects the Create operation, and is not stored in the AccelByte backend
+	if data.Overwrite.IsNull() || data.Overwrite.IsUnknown() {
+		data.Overwrite = types.BoolValue(false)
+	}
+
+	return diags
+}
+
+// This copies the API's TTL configuration to a TF state object, given the previous TF state object `value`.
+func fromApiCloudsaveTtlConfig(ctx context.Context, value types.Object, ttlConfig *cloudsaveclientmodels.ModelsTTLConfigDTO) (types.Object, diag.Diagnostics) {
+
+	if ttlConfig == nil {
+		return types.ObjectNull(AccelByteCloudsaveTtlConfigModelAttributeTypes), nil
+	}
+
+	var diags diag.Diagnostics = nil
+
+	var ttlConfigModel AccelByteCloudsaveTtlConfigModel
+	if !value.IsNull() && !value.IsUnknown() {
+		diags.Append(value.As(ctx, &ttlConfigModel, basetypes.ObjectAsOptions{})...)
+	}
+
+	ttlConfigModel.Action = types.StringPointerValue(ttlConfig.Action)
+	ttlConfigModel.ExpiresAt = fromApiTimestamp(ttlConfigModel.ExpiresAt, &ttlConfig.ExpiresAt)
+
+	object, objectDiags := basetypes.NewObjectValueFrom(ctx, AccelByteCloudsaveTtlConfigModelAttributeTypes, ttlConfigModel)
+	diags.Append(objectDiags...)
+
+	return object, diags
+}
+
+// This reads the TF state object `value` and returns the API's TTL configuration, or nil if there is none.
+func toApiCloudsaveTtlConfig(ctx context.Context, value types.Object) (*cloudsaveclientmodels.ModelsTTLConfigDTO, diag.Diagnostics) {
+
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	var diags diag.Diagnostics = nil
+
+	var ttlConfigModel AccelByteCloudsaveTtlConfigModel
+	diags.Append(value.As(ctx, &ttlConfigModel, basetypes.ObjectAsOptions{})...)
+
+	expiresAt, err := time.Parse(time.RFC3339, ttlConfigModel.ExpiresAt.ValueString())
+	if err != nil {
+		diags.AddError("Invalid expiry time", fmt.Sprintf("Unable to parse expiry time '%s' as an RFC3339 timestamp, got error: %s", ttlConfigModel.ExpiresAt.ValueString(), err))
+		return nil, diags
+	}
+
+	return &cloudsaveclientmodels.ModelsTTLConfigDTO{
+		Action:    ttlConfigModel.Action.ValueStringPointer(),
+		ExpiresAt: strfmt.DateTime(expiresAt),
+	}, diags
+}
+
+// Used by Create/Update operations on admin game records.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// CloudSave expects the record's metadata to be passed in a reserved field, next to the record's own fields.
+func toApiCloudsaveAdminGameRecord(ctx context.Context, data AccelByteCloudsaveAdminGameRecordModel) (map[string]interface{}, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	record := map[string]interface{}{}
+	diags.Append(data.Value.Unmarshal(&record)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tags := []string{}
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	metadata := map[string]interface{}{
+		"tags": tags,
+	}
+
+	ttlConfig, ttlConfigDiags := toApiCloudsaveTtlConfig(ctx, data.TtlConfig)
+	diags.Append(ttlConfigDiags...)
+	if ttlConfig != nil {
+		metadata["ttl_config"] = ttlConfig
+	}
+
+	record[CLOUDSAVE_RECORD_METADATA_FIELD] = metadata
+
+	return record, diags
+}
+
+func computeCloudsaveAdminGameRecordId(namespace string, key string) string {
+	return fmt.Sprintf("%s/%s", namespace, key)
+}
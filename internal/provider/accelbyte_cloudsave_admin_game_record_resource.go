@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclient/admin_record"
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/cloudsave"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteCloudsaveAdminGameRecordResource{}
+var _ resource.ResourceWithImportState = &AccelByteCloudsaveAdminGameRecordResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteCloudsaveAdminGameRecordResource{}
+
+func NewAccelByteCloudsaveAdminGameRecordResource() resource.Resource {
+	return &AccelByteCloudsaveAdminGameRecordResource{}
+}
+
+// AccelByteCloudsaveAdminGameRecordResource defines the resource implementation.
+type AccelByteCloudsaveAdminGameRecordResource struct {
+	client *cloudsave.AdminRecordService
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsave_admin_game_record"
+}
+
+// Schema for the `ttl_config` attribute of CloudSave records.
+func cloudsaveTtlConfigAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "If set, the record expires at the given time. Removing the TTL configuration from an existing record forces the record to be re-created.",
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Action to take when the record expires. Allowed values: `DELETE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(cloudsaveclientmodels.ModelsTTLConfigDTOActionDELETE),
+				Validators: []validator.String{
+					stringvalidator.OneOf(cloudsaveclientmodels.ModelsTTLConfigDTOActionDELETE),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Time at which the record expires, as an RFC3339 timestamp; for example `2030-01-01T00:00:00Z`.",
+				Required:            true,
+			},
+		},
+		Optional: true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplaceIf(
+				func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = !req.StateValue.IsNull() && req.PlanValue.IsNull()
+				},
+				"CloudSave does not support removing the TTL configuration from a record; removing ttl_config forces the record to be re-created.",
+				"CloudSave does not support removing the TTL configuration from a record; removing `ttl_config` forces the record to be re-created.",
+			),
+		},
+	}
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [CloudSave admin game record](https://docs.accelbyte.io/gaming-services/services/storage/cloudsave/). Admin game records hold namespace-wide JSON data, such as server-side configuration, which can only be accessed by game servers and administrators.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the record. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Key of the record. Changing this forces the record to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Admin game record identifier, on the format `{{namespace}}/{{key}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Content of the record, as a JSON object. Use `jsonencode()` to construct it. Differences in whitespace and key ordering are not considered to be changes. Field names cannot contain `.` or start with `$`.",
+				CustomType:          jsontypes.NormalizedType{},
+				Required:            true,
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the record.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+			},
+			"ttl_config": cloudsaveTtlConfigAttribute(),
+			"overwrite": schema.BoolAttribute{
+				MarkdownDescription: "If set to `false`, creating the resource fails if a record with the same key already exists. If set to `true`, an existing record is overwritten instead.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteCloudsaveAdminGameRecordModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCloudsaveRecordConfig(ctx, data.Value, data.TtlConfig)...)
+}
+
+// Used by ValidateConfig operations on CloudSave records.
+// The value must be a JSON object, and the TTL configuration must contain a valid timestamp.
+func validateCloudsaveRecordConfig(ctx context.Context, value jsontypes.Normalized, ttlConfig types.Object) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	if !value.IsNull() && !value.IsUnknown() {
+		var record map[string]interface{}
+		if valueDiags := value.Unmarshal(&record); valueDiags.HasError() || record == nil {
+			diags.AddAttributeError(
+				path.Root("value"),
+				"Invalid attribute value",
+				"Attribute `value` must be a JSON object",
+			)
+		} else if _, ok := record[CLOUDSAVE_RECORD_METADATA_FIELD]; ok {
+			diags.AddAttributeError(
+				path.Root("value"),
+				"Invalid attribute value",
+				fmt.Sprintf("Attribute `value` must not contain the field `%s`, which is reserved for record metadata", CLOUDSAVE_RECORD_METADATA_FIELD),
+			)
+		}
+	}
+
+	if !ttlConfig.IsNull() && !ttlConfig.IsUnknown() {
+		var ttlConfigModel AccelByteCloudsaveTtlConfigModel
+		diags.Append(ttlConfig.As(ctx, &ttlConfigModel, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+		if !ttlConfigModel.ExpiresAt.IsNull() && !ttlConfigModel.ExpiresAt.IsUnknown() {
+			if _, err := time.Parse(time.RFC3339, ttlConfigModel.ExpiresAt.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("ttl_config").AtName("expires_at"),
+					"Invalid attribute value",
+					fmt.Sprintf("Attribute `ttl_config.expires_at` must be an RFC3339 timestamp, got error: %s", err),
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.CloudsaveAdminRecordService
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteCloudsaveAdminGameRecordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API's create-or-replace operation silently replaces existing records
+	// Unless the user has opted in to overwriting, check that the record does not exist yet
+	if !data.Overwrite.ValueBool() {
+		getInput := &admin_record.AdminGetAdminGameRecordV1Params{
+			Namespace: data.Namespace.ValueString(),
+			Key:       data.Key.ValueString(),
+		}
+
+		_, err := r.client.AdminGetAdminGameRecordV1Short(getInput)
+		if err == nil {
+			resp.Diagnostics.AddError("Record already exists", fmt.Sprintf("Admin game record '%s' already exists in namespace '%s'. Import it into the Terraform state, or set `overwrite = true` to replace it.", getInput.Key, getInput.Namespace))
+			return
+		}
+
+		notFoundError := &admin_record.AdminGetAdminGameRecordV1NotFound{}
+		if !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Error when reading admin game record via AccelByte API", fmt.Sprintf("Unable to read admin game record '%s' in namespace '%s', got error: %s", getInput.Key, getInput.Namespace, err))
+			return
+		}
+	}
+
+	apiRecord, apiRecordDiags := toApiCloudsaveAdminGameRecord(ctx, data)
+	resp.Diagnostics.Append(apiRecordDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating admin game record via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+	})
+
+	input := &admin_record.AdminPutAdminGameRecordV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+		Body:      apiRecord,
+	}
+
+	record, err := r.client.AdminPutAdminGameRecordV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating admin game record via AccelByte API", fmt.Sprintf("Unable to create admin game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiCloudsaveAdminGameRecord(ctx, &data, record)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteCloudsaveAdminGameRecordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &admin_record.AdminGetAdminGameRecordV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	}
+
+	record, err := r.client.AdminGetAdminGameRecordV1Short(input)
+	if err != nil {
+		notFoundError := &admin_record.AdminGetAdminGameRecordV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading admin game record via AccelByte API", fmt.Sprintf("Unable to read admin game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read admin game record from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+		"record":    record,
+	})
+
+	resp.Diagnostics.Append(updateFromApiCloudsaveAdminGameRecord(ctx, &data, record)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteCloudsaveAdminGameRecordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRecord, apiRecordDiags := toApiCloudsaveAdminGameRecord(ctx, data)
+	resp.Diagnostics.Append(apiRecordDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating admin game record via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+	})
+
+	// The API replaces the record's content wholesale
+	// If the record has disappeared since the TF state was refreshed, it will be re-created
+	input := &admin_record.AdminPutAdminGameRecordV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+		Body:      apiRecord,
+	}
+
+	record, err := r.client.AdminPutAdminGameRecordV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when updating admin game record via AccelByte API", fmt.Sprintf("Unable to update admin game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiCloudsaveAdminGameRecord(ctx, &data, record)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteCloudsaveAdminGameRecordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting admin game record via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+	})
+
+	input := &admin_record.AdminDeleteAdminGameRecordV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	}
+	err := r.client.AdminDeleteAdminGameRecordV1Short(input)
+	if err != nil {
+		notFoundError := &admin_record.AdminDeleteAdminGameRecordV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting admin game record via AccelByte API", fmt.Sprintf("Unable to delete admin game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteCloudsaveAdminGameRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "key")
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclientmodels"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteCloudsaveGameRecordModel is used by AccelByteCloudsaveGameRecordResource.
+type AccelByteCloudsaveGameRecordModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	Key       types.String `tfsdk:"key"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	// The value is compared semantically, so that whitespace and key ordering differences between
+	// the user's JSON and the JSON returned by the API do not result in a diff
+	Value jsontypes.Normalized `tfsdk:"value"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	SetBy     types.String `tfsdk:"set_by"`
+	Tags      types.Set    `tfsdk:"tags"`       // []string
+	TtlConfig types.Object `tfsdk:"ttl_config"` // AccelByteCloudsaveTtlConfigModel
+
+	// Computed during Read() operation
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+// Used by Create, Read and Update operations on game records.
+// This copies data from the AccelByte API `record` to the TF state `data`.
+func updateFromApiCloudsaveGameRecord(ctx context.Context, data *AccelByteCloudsaveGameRecordModel, record *cloudsaveclientmodels.ModelsGameRecordAdminResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*record.Namespace)
+	data.Key = types.StringValue(*record.Key)
+	data.Id = types.StringValue(computeCloudsaveGameRecordId(*record.Namespace, *record.Key))
+
+	value, err := json.Marshal(record.Value)
+	if err != nil {
+		diags.AddError("Unable to convert API's game record value to JSON", fmt.Sprintf("Unable to convert value of game record '%s' to JSON, got error: %s", *record.Key, err))
+		return diags
+	}
+	data.Value = jsontypes.NewNormalizedValue(string(value))
+
+	data.SetBy = types.StringValue(record.SetBy)
+
+	tags, tagsDiags := setValueFromEvenIfNil(ctx, types.StringType, record.Tags)
+	data.Tags = tags
+	diags.Append(tagsDiags...)
+
+	ttlConfig, ttlConfigDiags := fromApiCloudsaveTtlConfig(ctx, data.TtlConfig, record.TTLConfig)
+	data.TtlConfig = ttlConfig
+	diags.Append(ttlConfigDiags...)
+
+	// The update timestamp is used as a precondition when updating the record, so retain its full precision
+	data.UpdatedAt = types.StringValue(time.Time(record.UpdatedAt).UTC().Format(time.RFC3339Nano))
+
+	return diags
+}
+
+// Used by the Create operation on game records.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// CloudSave expects the record's metadata to be passed in a reserved field, next to the record's own fields.
+func toApiCloudsaveGameRecordCreate(ctx context.Context, data AccelByteCloudsaveGameRecordModel) (map[string]interface{}, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	record := map[string]interface{}{}
+	diags.Append(data.Value.Unmarshal(&record)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tags := []string{}
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	metadata := map[string]interface{}{
+		"set_by": data.SetBy.ValueString(),
+		"tags":   tags,
+	}
+
+	ttlConfig, ttlConfigDiags := toApiCloudsaveTtlConfig(ctx, data.TtlConfig)
+	diags.Append(ttlConfigDiags...)
+	if ttlConfig != nil {
+		metadata["ttl_config"] = ttlConfig
+	}
+
+	record[CLOUDSAVE_RECORD_METADATA_FIELD] = metadata
+
+	return record, diags
+}
+
+// Used by the Update operation on game records.
+// This reads from the TF plan `data` and returns an AccelByte API object.
+// The update is only applied by the API if the record has not been modified since `updatedAt`.
+func toApiCloudsaveGameRecordUpdate(ctx context.Context, data AccelByteCloudsaveGameRecordModel, updatedAt types.String) (*cloudsaveclientmodels.ModelsAdminConcurrentRecordRequest, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	var value map[string]interface{}
+	diags.Append(data.Value.Unmarshal(&value)...)
+
+	tags := []string{}
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	ttlConfig, ttlConfigDiags := toApiCloudsaveTtlConfig(ctx, data.TtlConfig)
+	diags.Append(ttlConfigDiags...)
+
+	precondition, err := strfmt.ParseDateTime(updatedAt.ValueString())
+	if err != nil {
+		diags.AddError("Invalid update time", fmt.Sprintf("Unable to parse update time '%s' of game record '%s', got error: %s", updatedAt.ValueString(), data.Key.ValueString(), err))
+	}
+
+	return &cloudsaveclientmodels.ModelsAdminConcurrentRecordRequest{
+		SetBy:     data.SetBy.ValueStringPointer(),
+		Tags:      tags,
+		TTLConfig: ttlConfig,
+		UpdatedAt: precondition,
+		Value:     value,
+	}, diags
+}
+
+func computeCloudsaveGameRecordId(namespace string, key string) string {
+	return fmt.Sprintf("%s/%s", namespace, key)
+}
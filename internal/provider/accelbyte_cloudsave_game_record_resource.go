@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclient/admin_concurrent_record"
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclient/admin_game_record"
+	"github.com/AccelByte/accelbyte-go-sdk/cloudsave-sdk/pkg/cloudsaveclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/cloudsave"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteCloudsaveGameRecordResource{}
+var _ resource.ResourceWithImportState = &AccelByteCloudsaveGameRecordResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteCloudsaveGameRecordResource{}
+
+func NewAccelByteCloudsaveGameRecordResource() resource.Resource {
+	return &AccelByteCloudsaveGameRecordResource{}
+}
+
+// AccelByteCloudsaveGameRecordResource defines the resource implementation.
+type AccelByteCloudsaveGameRecordResource struct {
+	client           *cloudsave.AdminGameRecordService
+	concurrentClient *cloudsave.AdminConcurrentRecordService
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudsave_game_record"
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [CloudSave game record](https://docs.accelbyte.io/gaming-services/services/storage/cloudsave/). Game records hold namespace-wide JSON data which can be read by all game clients. Depending on `set_by`, they can be modified either by game servers only, or also by game clients.\n\nGame servers may modify these records while Terraform manages them. If a record has been modified since Terraform last read it, Terraform reports a conflict instead of overwriting the modification.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the record. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Key of the record. Changing this forces the record to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Game record identifier, on the format `{{namespace}}/{{key}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Content of the record, as a JSON object. Use `jsonencode()` to construct it. Differences in whitespace and key ordering are not considered to be changes. Field names cannot contain `.` or start with `$`.",
+				CustomType:          jsontypes.NormalizedType{},
+				Required:            true,
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"set_by": schema.StringAttribute{
+				MarkdownDescription: "Which party is allowed to modify the record. `SERVER`: game servers only. `CLIENT`: game clients and game servers. Allowed values: `CLIENT`, `SERVER`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(cloudsaveclientmodels.ModelsAdminConcurrentRecordRequestSetBySERVER),
+				Validators: []validator.String{
+					stringvalidator.OneOf(cloudsaveclientmodels.ModelsAdminConcurrentRecordRequestSetByCLIENT, cloudsaveclientmodels.ModelsAdminConcurrentRecordRequestSetBySERVER),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the record.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+			},
+			"ttl_config": cloudsaveTtlConfigAttribute(),
+
+			// Computed during Read() operation
+
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "Time at which the record was last modified, as an RFC3339 timestamp. Updates are only applied if the record has not been modified since this time.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteCloudsaveGameRecordModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateCloudsaveRecordConfig(ctx, data.Value, data.TtlConfig)...)
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.CloudsaveAdminGameRecordService
+	r.concurrentClient = clients.CloudsaveAdminConcurrentRecordService
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteCloudsaveGameRecordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API's create-or-replace operation silently replaces existing records
+	// Check that the record does not exist yet, so that a record written by a game server is not overwritten
+	getInput := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	}
+
+	_, err := r.client.AdminGetGameRecordHandlerV1Short(getInput)
+	if err == nil {
+		resp.Diagnostics.AddError("Record already exists", fmt.Sprintf("Game record '%s' already exists in namespace '%s'. Import it into the Terraform state to manage it.", getInput.Key, getInput.Namespace))
+		return
+	}
+
+	notFoundError := &admin_game_record.AdminGetGameRecordHandlerV1NotFound{}
+	if !errors.As(err, &notFoundError) {
+		resp.Diagnostics.AddError("Error when reading game record via AccelByte API", fmt.Sprintf("Unable to read game record '%s' in namespace '%s', got error: %s", getInput.Key, getInput.Namespace, err))
+		return
+	}
+
+	apiRecord, apiRecordDiags := toApiCloudsaveGameRecordCreate(ctx, data)
+	resp.Diagnostics.Append(apiRecordDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating game record via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+	})
+
+	input := &admin_game_record.AdminPutGameRecordHandlerV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+		Body:      apiRecord,
+	}
+
+	record, err := r.client.AdminPutGameRecordHandlerV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating game record via AccelByte API", fmt.Sprintf("Unable to create game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiCloudsaveGameRecord(ctx, &data, record)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteCloudsaveGameRecordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	}
+
+	record, err := r.client.AdminGetGameRecordHandlerV1Short(input)
+	if err != nil {
+		notFoundError := &admin_game_record.AdminGetGameRecordHandlerV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading game record via AccelByte API", fmt.Sprintf("Unable to read game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read game record from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+		"record":    record,
+	})
+
+	resp.Diagnostics.Append(updateFromApiCloudsaveGameRecord(ctx, &data, record)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteCloudsaveGameRecordModel
+	var state AccelByteCloudsaveGameRecordModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// Read Terraform prior state data; its update time is used as the precondition for the update
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRecord, apiRecordDiags := toApiCloudsaveGameRecordUpdate(ctx, data, state.UpdatedAt)
+	resp.Diagnostics.Append(apiRecordDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating game record via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+		"updatedAt": state.UpdatedAt,
+	})
+
+	input := &admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+		Body:      apiRecord,
+	}
+
+	err := r.concurrentClient.AdminPutGameRecordConcurrentHandlerV1Short(input)
+	if err != nil {
+		preconditionFailedError := &admin_concurrent_record.AdminPutGameRecordConcurrentHandlerV1PreconditionFailed{}
+		if errors.As(err, &preconditionFailedError) {
+			// The record has been modified since the TF state was refreshed, for example by a game server
+			// Overwriting it would silently discard that modification; we should abort
+			resp.Diagnostics.AddError("Conflict when updating game record", fmt.Sprintf("Game record '%s' in namespace '%s' has been modified since it was last read at %s. Refresh the Terraform state and review the changes before applying again.", input.Key, input.Namespace, state.UpdatedAt.ValueString()))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating game record via AccelByte API", fmt.Sprintf("Unable to update game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+			return
+		}
+	}
+
+	// Fetch game record immediately after updating it; the update operation does not return the record
+
+	getInput := &admin_game_record.AdminGetGameRecordHandlerV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	}
+
+	record, err := r.client.AdminGetGameRecordHandlerV1Short(getInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading game record via AccelByte API", fmt.Sprintf("Unable to read game record '%s' in namespace '%s', got error: %s", getInput.Key, getInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiCloudsaveGameRecord(ctx, &data, record)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteCloudsaveGameRecordModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting game record via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"key":       data.Key,
+	})
+
+	input := &admin_game_record.AdminDeleteGameRecordHandlerV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Key:       data.Key.ValueString(),
+	}
+	err := r.client.AdminDeleteGameRecordHandlerV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting game record via AccelByte API", fmt.Sprintf("Unable to delete game record '%s' in namespace '%s', got error: %s", input.Key, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteCloudsaveGameRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "key")
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/csm-sdk/pkg/csmclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteExtendAppModel is used by AccelByteExtendAppResource.
+type AccelByteExtendAppModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	Name      types.String `tfsdk:"name"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Scenario types.String `tfsdk:"scenario"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or from the API
+	Description                            types.String `tfsdk:"description"`
+	MinReplicas                            types.Int32  `tfsdk:"min_replicas"`
+	MaxReplicas                            types.Int32  `tfsdk:"max_replicas"`
+	CpuMillicores                          types.Int32  `tfsdk:"cpu_millicores"`
+	MemoryMib                              types.Int32  `tfsdk:"memory_mib"`
+	AutoscalingTargetCpuUtilizationPercent types.Int32  `tfsdk:"autoscaling_target_cpu_utilization_percent"`
+	EnvironmentVariables                   types.Map    `tfsdk:"environment_variables"` // map[string]string
+	Secrets                                types.Map    `tfsdk:"secrets"`               // map[string]string
+
+	// Computed during Read() operation
+	AppStatus  types.String `tfsdk:"app_status"`
+	ServiceUrl types.String `tfsdk:"service_url"`
+}
+
+// Used by Create, Read and Update operations on Extend apps.
+// This copies data from the AccelByte API `app` to the TF state `data`.
+func updateFromApiExtendApp(ctx context.Context, data *AccelByteExtendAppModel, app *csmclientmodels.ApimodelAppItem) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Name = types.StringValue(*app.AppName)
+	data.Id = types.StringValue(computeExtendAppId(data.Namespace.ValueString(), *app.AppName))
+
+	data.Scenario = types.StringValue(*app.Scenario)
+	data.Description = types.StringValue(app.Description)
+
+	if app.Replica != nil {
+		data.MinReplicas = types.Int32Value(app.Replica.MinReplica)
+		data.MaxReplicas = types.Int32Value(app.Replica.MaxReplica)
+	} else {
+		data.MinReplicas = types.Int32Null()
+		data.MaxReplicas = types.Int32Null()
+	}
+
+	if app.CPU != nil && app.CPU.RequestCPU != nil {
+		data.CpuMillicores = types.Int32Value(*app.CPU.RequestCPU)
+	} else {
+		data.CpuMillicores = types.Int32Null()
+	}
+
+	if app.Memory != nil {
+		data.MemoryMib = types.Int32Value(app.Memory.RequestMemory)
+	} else {
+		data.MemoryMib = types.Int32Null()
+	}
+
+	if app.Autoscaling != nil && app.Autoscaling.TargetCPUUtilizationPercent != nil {
+		data.AutoscalingTargetCpuUtilizationPercent = types.Int32Value(*app.Autoscaling.TargetCPUUtilizationPercent)
+	} else {
+		data.AutoscalingTargetCpuUtilizationPercent = types.Int32Null()
+	}
+
+	data.AppStatus = types.StringValue(*app.AppStatus)
+	data.ServiceUrl = types.StringValue(app.ServiceURL)
+
+	return diags
+}
+
+// Used by Create, Read and Update operations on Extend apps.
+// This copies the AccelByte API environment variables `variables` to the TF state `data`.
+func updateFromApiExtendAppVariables(ctx context.Context, data *AccelByteExtendAppModel, variables []*csmclientmodels.ApimodelGetListOfConfigurationsV2DataItem) diag.Diagnostics {
+
+	environmentVariables := map[string]string{}
+	for _, variable := range variables {
+		environmentVariables[*variable.ConfigName] = *variable.Value
+	}
+
+	environmentVariablesMap, diags := types.MapValueFrom(ctx, types.StringType, environmentVariables)
+	data.EnvironmentVariables = environmentVariablesMap
+	return diags
+}
+
+// Used by Create, Read and Update operations on Extend apps.
+// This reconciles the TF state `data` with the names of the AccelByte API secrets `secrets`.
+// The API never returns secret values, so the values already in the TF state are kept as-is.
+// Secrets that have been removed outside of Terraform are dropped from the state, and secrets that have been
+// added outside of Terraform are added with an empty value; both will show up as changes during the next plan.
+// If the TF state does not manage secrets, it is left untouched.
+func updateFromApiExtendAppSecrets(ctx context.Context, data *AccelByteExtendAppModel, secrets []*csmclientmodels.ApimodelGetListOfConfigurationsV2DataItem) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	if data.Secrets.IsNull() || data.Secrets.IsUnknown() {
+		return diags
+	}
+
+	knownSecrets := map[string]string{}
+	diags.Append(data.Secrets.ElementsAs(ctx, &knownSecrets, false)...)
+
+	reconciledSecrets := map[string]string{}
+	for _, secret := range secrets {
+		reconciledSecrets[*secret.ConfigName] = knownSecrets[*secret.ConfigName]
+	}
+
+	secretsMap, secretsDiags := types.MapValueFrom(ctx, types.StringType, reconciledSecrets)
+	data.Secrets = secretsMap
+	diags.Append(secretsDiags...)
+
+	return diags
+}
+
+// Used by the Create operation on Extend apps.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiExtendAppCreateRequest(data AccelByteExtendAppModel) *csmclientmodels.ApimodelCreateAppV2Request {
+
+	resources := toApiExtendAppResourceRequest(data)
+
+	return &csmclientmodels.ApimodelCreateAppV2Request{
+		Scenario:    data.Scenario.ValueStringPointer(),
+		Description: data.Description.ValueString(),
+		Autoscaling: resources.Autoscaling,
+		CPU:         resources.CPU,
+		Memory:      resources.Memory,
+		Replica:     resources.Replica,
+	}
+}
+
+// Used by Create/Update operations on Extend apps.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Settings that are not known at this point are omitted, so that the API keeps its current values (or defaults).
+func toApiExtendAppResourceRequest(data AccelByteExtendAppModel) *csmclientmodels.ApimodelUpdateAppResourceRequest {
+
+	request := &csmclientmodels.ApimodelUpdateAppResourceRequest{}
+
+	if !data.AutoscalingTargetCpuUtilizationPercent.IsNull() && !data.AutoscalingTargetCpuUtilizationPercent.IsUnknown() {
+		request.Autoscaling = &csmclientmodels.ApimodelAutoscalingRequest{
+			TargetCPUUtilizationPercent: data.AutoscalingTargetCpuUtilizationPercent.ValueInt32Pointer(),
+		}
+	}
+
+	if !data.CpuMillicores.IsNull() && !data.CpuMillicores.IsUnknown() {
+		request.CPU = &csmclientmodels.ApimodelCPURequest{
+			RequestCPU: data.CpuMillicores.ValueInt32Pointer(),
+		}
+	}
+
+	if !data.MemoryMib.IsNull() && !data.MemoryMib.IsUnknown() {
+		request.Memory = &csmclientmodels.ApimodelMemoryRequest{
+			RequestMemory: data.MemoryMib.ValueInt32(),
+		}
+	}
+
+	if !data.MinReplicas.IsNull() && !data.MinReplicas.IsUnknown() && !data.MaxReplicas.IsNull() && !data.MaxReplicas.IsUnknown() {
+		request.Replica = &csmclientmodels.ApimodelReplicaRequest{
+			MinReplica: data.MinReplicas.ValueInt32(),
+			MaxReplica: data.MaxReplicas.ValueInt32(),
+		}
+	}
+
+	return request
+}
+
+func computeExtendAppId(namespace string, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
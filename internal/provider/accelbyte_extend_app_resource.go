@@ -0,0 +1,769 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/csm-sdk/pkg/csmclient/app_v2"
+	"github.com/AccelByte/accelbyte-go-sdk/csm-sdk/pkg/csmclient/configuration_v2"
+	"github.com/AccelByte/accelbyte-go-sdk/csm-sdk/pkg/csmclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/csm"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteExtendAppResource{}
+var _ resource.ResourceWithImportState = &AccelByteExtendAppResource{}
+
+const (
+	// The CSM API creates apps asynchronously; poll the app this often, for at most this long, until creation has completed.
+	EXTEND_APP_CREATION_POLL_INTERVAL_SECONDS = 10
+	EXTEND_APP_CREATION_TIMEOUT_SECONDS       = 600
+
+	// Number of environment variables / secrets to fetch per request to the CSM API.
+	EXTEND_APP_CONFIGURATIONS_PAGE_SIZE = 100
+)
+
+func NewAccelByteExtendAppResource() resource.Resource {
+	return &AccelByteExtendAppResource{}
+}
+
+// AccelByteExtendAppResource defines the resource implementation.
+type AccelByteExtendAppResource struct {
+	client              *csm.AppV2Service
+	configurationClient *csm.ConfigurationV2Service
+}
+
+func (r *AccelByteExtendAppResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extend_app"
+}
+
+func (r *AccelByteExtendAppResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an [Extend app](https://docs.accelbyte.io/gaming-services/services/extend/). Session templates and match pools refer to Extend apps by name. Deploying images to the app is not handled by this resource.\n\nIf the app is created but does not become ready, or its environment variables or secrets cannot be configured, the app is marked as tainted and will be replaced during the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the Extend app. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of Extend app. Letters, digits and dashes; must start with a letter and must not end with a dash.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z](?:[A-Za-z0-9\-]*[A-Za-z0-9])?$`), "must start with a letter, contain only letters, digits and dashes, and must not end with a dash"),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Extend app identifier, on the format `{{namespace}}/{{name}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"scenario": schema.StringAttribute{
+				MarkdownDescription: "Type of Extend app. One of `function-override` (Extend Override), `service-extension` (Extend Service Extension) or `event-handler` (Extend Event Handler). Changing this forces the app to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("function-override", "service-extension", "event-handler"),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of Extend app.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"environment_variables": schema.MapAttribute{
+				MarkdownDescription: "Environment variables for the Extend app. Any environment variables that are configured for the app but not listed here will be removed. Changes take effect the next time the app is restarted.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+			},
+			"secrets": schema.MapAttribute{
+				MarkdownDescription: "Secrets for the Extend app. These are presented to the app as environment variables. The AccelByte API never returns secret values, so changes made to secret values outside of Terraform will not be detected; secrets that are added or removed outside of Terraform will be detected. Any secrets that are configured for the app but not listed here will be removed. If this attribute is not set, Terraform will not manage the app's secrets at all. Changes take effect the next time the app is restarted. Secret values are stored in the Terraform state, like any other sensitive value; the plugin framework version used by this provider does not support write-only attributes.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from the API
+
+			"min_replicas": schema.Int32Attribute{
+				MarkdownDescription: "Minimum number of replicas of the app that will run. Must be set together with `max_replicas`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+					int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("max_replicas")),
+				},
+			},
+			"max_replicas": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of replicas of the app that will run. Must be set together with `min_replicas`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+					int32validator.AlsoRequires(path.MatchRelative().AtParent().AtName("min_replicas")),
+				},
+			},
+			"cpu_millicores": schema.Int32Attribute{
+				MarkdownDescription: "Amount of CPU requested for each replica, in millicores (1000 = one CPU core).",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"memory_mib": schema.Int32Attribute{
+				MarkdownDescription: "Amount of memory requested for each replica, in MiB.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"autoscaling_target_cpu_utilization_percent": schema.Int32Attribute{
+				MarkdownDescription: "Average CPU utilization, in percent, that the autoscaler will aim for when choosing the number of replicas between `min_replicas` and `max_replicas`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(1, 100),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"app_status": schema.StringAttribute{
+				MarkdownDescription: "Current status of the app, for example `undeployed` or `running`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_url": schema.StringAttribute{
+				MarkdownDescription: "URL at which the app is reachable from within AccelByte Gaming Services.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteExtendAppResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.CsmAppV2Service
+	r.configurationClient = clients.CsmConfigurationV2Service
+}
+
+func (r *AccelByteExtendAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteExtendAppModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCreateRequest := toApiExtendAppCreateRequest(data)
+
+	tflog.Trace(ctx, "Creating Extend app via AccelByte API", map[string]interface{}{
+		"namespace":        data.Namespace,
+		"name":             data.Name,
+		"apiCreateRequest": apiCreateRequest,
+	})
+
+	createInput := &app_v2.CreateAppV2Params{
+		Namespace: data.Namespace.ValueString(),
+		App:       data.Name.ValueString(),
+		Body:      apiCreateRequest,
+	}
+
+	app, err := r.client.CreateAppV2Short(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating Extend app via AccelByte API", fmt.Sprintf("Unable to create Extend app '%s' in namespace '%s', got error: %s", createInput.App, createInput.Namespace, err))
+		return
+	}
+
+	// Save the app into Terraform state before waiting for it and configuring it, so that the app is tracked even if any of those steps fail
+	// If a later step fails, Terraform marks the app as tainted, and the next apply deletes and re-creates it
+
+	plannedData := data
+	resp.Diagnostics.Append(updateFromApiExtendApp(ctx, &data, app)...)
+	data.EnvironmentVariables = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	if !data.Secrets.IsNull() {
+		data.Secrets = types.MapValueMust(types.StringType, map[string]attr.Value{})
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data = plannedData
+
+	// The app is created asynchronously; wait until it is ready before configuring it
+
+	_, err = r.waitForAppCreation(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating Extend app via AccelByte API", fmt.Sprintf("Extend app '%s' in namespace '%s' did not finish creating, got error: %s", createInput.App, createInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyConfigurations(ctx, data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteExtendAppResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteExtendAppModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &app_v2.GetAppV2Params{
+		Namespace: data.Namespace.ValueString(),
+		App:       data.Name.ValueString(),
+	}
+
+	app, err := r.client.GetAppV2Short(input)
+	if err != nil {
+		notFoundError := &app_v2.GetAppV2NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading Extend app via AccelByte API", fmt.Sprintf("Unable to read Extend app '%s' in namespace '%s', got error: %s", input.App, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read Extend app from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+		"app":       app,
+	})
+
+	resp.Diagnostics.Append(updateFromApiExtendApp(ctx, &data, app)...)
+	resp.Diagnostics.Append(r.readConfigurations(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteExtendAppResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteExtendAppModel
+	var state AccelByteExtendAppModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating Extend app via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+	})
+
+	if !data.Description.Equal(state.Description) {
+		input := &app_v2.UpdateAppV2Params{
+			Namespace: data.Namespace.ValueString(),
+			App:       data.Name.ValueString(),
+			Body: &csmclientmodels.ApimodelUpdateAppV2Request{
+				Description: data.Description.ValueString(),
+			},
+		}
+
+		_, err := r.client.UpdateAppV2Short(input)
+		if err != nil {
+			notFoundError := &app_v2.UpdateAppV2NotFound{}
+			if errors.As(err, &notFoundError) {
+				// The resource does not exist in the AccelByte backend
+				// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+				resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Extend app '%s' does not exist in namespace '%s'", input.App, input.Namespace))
+				return
+			} else {
+				// Failed to update the resource in the AccelByte backend
+				// The backend refused our update operation; we should abort
+				resp.Diagnostics.AddError("Error when updating Extend app via AccelByte API", fmt.Sprintf("Unable to update Extend app '%s' in namespace '%s', got error: %s", input.App, input.Namespace, err))
+				return
+			}
+		}
+	}
+
+	apiResourceRequest := toApiExtendAppResourceRequest(data)
+
+	tflog.Trace(ctx, "Updating Extend app resources via AccelByte API", map[string]interface{}{
+		"namespace":          data.Namespace,
+		"name":               data.Name,
+		"apiResourceRequest": apiResourceRequest,
+	})
+
+	resourcesInput := &app_v2.UpdateAppResourcesV2Params{
+		Namespace: data.Namespace.ValueString(),
+		App:       data.Name.ValueString(),
+		Body:      apiResourceRequest,
+	}
+
+	_, err := r.client.UpdateAppResourcesV2Short(resourcesInput)
+	if err != nil {
+		notFoundError := &app_v2.UpdateAppResourcesV2NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Extend app '%s' does not exist in namespace '%s'", resourcesInput.App, resourcesInput.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating Extend app via AccelByte API", fmt.Sprintf("Unable to update resources for Extend app '%s' in namespace '%s', got error: %s", resourcesInput.App, resourcesInput.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.applyConfigurations(ctx, data, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteExtendAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteExtendAppModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting Extend app via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+	})
+
+	// Delete the app regardless of whether it is currently running
+	forced := "true"
+
+	input := &app_v2.DeleteAppV2Params{
+		Namespace: data.Namespace.ValueString(),
+		App:       data.Name.ValueString(),
+		Forced:    &forced,
+	}
+	err := r.client.DeleteAppV2Short(input)
+	if err != nil {
+		notFoundError := &app_v2.DeleteAppV2NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting Extend app via AccelByte API", fmt.Sprintf("Unable to delete Extend app '%s' in namespace '%s', got error: %s", input.App, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteExtendAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "name")
+}
+
+// Fetch the app and its configuration after a write operation, so we can get the values for un-set defaults
+func (r *AccelByteExtendAppResource) read(ctx context.Context, data *AccelByteExtendAppModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	input := &app_v2.GetAppV2Params{
+		Namespace: data.Namespace.ValueString(),
+		App:       data.Name.ValueString(),
+	}
+
+	app, err := r.client.GetAppV2Short(input)
+	if err != nil {
+		diags.AddError("Error when reading Extend app via AccelByte API", fmt.Sprintf("Unable to read Extend app '%s' in namespace '%s', got error: %s", input.App, input.Namespace, err))
+		return diags
+	}
+
+	diags.Append(updateFromApiExtendApp(ctx, data, app)...)
+	diags.Append(r.readConfigurations(ctx, data)...)
+
+	return diags
+}
+
+// Poll the app until the CSM API has finished creating it
+func (r *AccelByteExtendAppResource) waitForAppCreation(ctx context.Context, namespace string, name string) (*csmclientmodels.ApimodelAppItem, error) {
+
+	input := &app_v2.GetAppV2Params{
+		Namespace: namespace,
+		App:       name,
+	}
+
+	deadline := time.Now().Add(EXTEND_APP_CREATION_TIMEOUT_SECONDS * time.Second)
+
+	for {
+		app, err := r.client.GetAppV2Short(input)
+		if err != nil {
+			return nil, err
+		}
+
+		switch *app.AppStatus {
+		case "app-creation-inprogress":
+			// Keep waiting
+		case "app-creation-failed":
+			return nil, fmt.Errorf("app creation failed: %s", app.Message)
+		default:
+			return app, nil
+		}
+
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("app is still being created after %d seconds", EXTEND_APP_CREATION_TIMEOUT_SECONDS)
+		}
+
+		tflog.Trace(ctx, "Waiting for Extend app creation to complete", map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+			"appStatus": *app.AppStatus,
+		})
+
+		// Stop waiting if Terraform is interrupted or the operation times out
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(EXTEND_APP_CREATION_POLL_INTERVAL_SECONDS * time.Second):
+		}
+	}
+}
+
+// Fetch all environment variables, or all secrets, for the app
+func (r *AccelByteExtendAppResource) listConfigurations(namespace string, name string, secrets bool) ([]*csmclientmodels.ApimodelGetListOfConfigurationsV2DataItem, error) {
+
+	configurations := []*csmclientmodels.ApimodelGetListOfConfigurationsV2DataItem{}
+
+	limit := int64(EXTEND_APP_CONFIGURATIONS_PAGE_SIZE)
+	offset := int64(0)
+
+	for {
+		var page *csmclientmodels.ApimodelGetListOfConfigurationsV2Response
+		var err error
+		if secrets {
+			page, err = r.configurationClient.GetListOfSecretsV2Short(&configuration_v2.GetListOfSecretsV2Params{
+				Namespace: namespace,
+				App:       name,
+				Limit:     &limit,
+				Offset:    &offset,
+			})
+		} else {
+			page, err = r.configurationClient.GetListOfVariablesV2Short(&configuration_v2.GetListOfVariablesV2Params{
+				Namespace: namespace,
+				App:       name,
+				Limit:     &limit,
+				Offset:    &offset,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		configurations = append(configurations, page.Data...)
+
+		if int64(len(page.Data)) < limit {
+			return configurations, nil
+		}
+		offset += limit
+	}
+}
+
+// Update the TF state `data` with the environment variables and secrets that are currently configured for the app
+func (r *AccelByteExtendAppResource) readConfigurations(ctx context.Context, data *AccelByteExtendAppModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	variables, err := r.listConfigurations(data.Namespace.ValueString(), data.Name.ValueString(), false)
+	if err != nil {
+		diags.AddError("Error when reading Extend app environment variables via AccelByte API", fmt.Sprintf("Unable to read environment variables for Extend app '%s' in namespace '%s', got error: %s", data.Name.ValueString(), data.Namespace.ValueString(), err))
+		return diags
+	}
+	diags.Append(updateFromApiExtendAppVariables(ctx, data, variables)...)
+
+	if !data.Secrets.IsNull() {
+		secrets, err := r.listConfigurations(data.Namespace.ValueString(), data.Name.ValueString(), true)
+		if err != nil {
+			diags.AddError("Error when reading Extend app secrets via AccelByte API", fmt.Sprintf("Unable to read secrets for Extend app '%s' in namespace '%s', got error: %s", data.Name.ValueString(), data.Namespace.ValueString(), err))
+			return diags
+		}
+		diags.Append(updateFromApiExtendAppSecrets(ctx, data, secrets)...)
+	}
+
+	return diags
+}
+
+// Make the environment variables and secrets configured for the app match the TF plan `data`.
+// `state` is the prior TF state, or nil during resource creation.
+func (r *AccelByteExtendAppResource) applyConfigurations(ctx context.Context, data AccelByteExtendAppModel, state *AccelByteExtendAppModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	desiredVariables := map[string]string{}
+	diags.Append(data.EnvironmentVariables.ElementsAs(ctx, &desiredVariables, false)...)
+
+	diags.Append(r.applyConfigurationsOfType(ctx, data, desiredVariables, nil, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if !data.Secrets.IsNull() {
+		desiredSecrets := map[string]string{}
+		diags.Append(data.Secrets.ElementsAs(ctx, &desiredSecrets, false)...)
+
+		// Secret values cannot be read back from the API, so changes are detected by comparing against the prior state
+		previousSecrets := map[string]string{}
+		if state != nil && !state.Secrets.IsNull() {
+			diags.Append(state.Secrets.ElementsAs(ctx, &previousSecrets, false)...)
+		}
+
+		diags.Append(r.applyConfigurationsOfType(ctx, data, desiredSecrets, previousSecrets, true)...)
+	}
+
+	return diags
+}
+
+// Create, update and delete either environment variables or secrets for the app, so that they match `desired`.
+// For environment variables, values are compared against those currently configured for the app.
+// For secrets, values are compared against `previous` since the API does not return secret values.
+func (r *AccelByteExtendAppResource) applyConfigurationsOfType(ctx context.Context, data AccelByteExtendAppModel, desired map[string]string, previous map[string]string, secrets bool) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	namespace := data.Namespace.ValueString()
+	name := data.Name.ValueString()
+
+	configurationType := "environment variable"
+	if secrets {
+		configurationType = "secret"
+	}
+
+	existing, err := r.listConfigurations(namespace, name, secrets)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error when reading Extend app %ss via AccelByte API", configurationType), fmt.Sprintf("Unable to read %ss for Extend app '%s' in namespace '%s', got error: %s", configurationType, name, namespace, err))
+		return diags
+	}
+
+	existingByName := map[string]*csmclientmodels.ApimodelGetListOfConfigurationsV2DataItem{}
+	for _, configuration := range existing {
+		existingByName[*configuration.ConfigName] = configuration
+	}
+
+	// Remove configurations that are no longer desired
+
+	for configName, configuration := range existingByName {
+		if _, ok := desired[configName]; ok {
+			continue
+		}
+
+		tflog.Trace(ctx, "Deleting Extend app configuration via AccelByte API", map[string]interface{}{
+			"namespace":         namespace,
+			"name":              name,
+			"configurationType": configurationType,
+			"configName":        configName,
+		})
+
+		if secrets {
+			err = r.configurationClient.DeleteSecretV2Short(&configuration_v2.DeleteSecretV2Params{
+				Namespace: namespace,
+				App:       name,
+				ConfigID:  *configuration.ConfigID,
+			})
+		} else {
+			err = r.configurationClient.DeleteVariableV2Short(&configuration_v2.DeleteVariableV2Params{
+				Namespace: namespace,
+				App:       name,
+				ConfigID:  *configuration.ConfigID,
+			})
+		}
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Error when deleting Extend app %s via AccelByte API", configurationType), fmt.Sprintf("Unable to delete %s '%s' for Extend app '%s' in namespace '%s', got error: %s", configurationType, configName, name, namespace, err))
+			return diags
+		}
+	}
+
+	// Create or update the remaining configurations
+
+	for configName, value := range desired {
+		value := value
+
+		existingConfiguration, exists := existingByName[configName]
+		if exists {
+			changed := false
+			if secrets {
+				previousValue, ok := previous[configName]
+				changed = !ok || previousValue != value
+			} else {
+				changed = existingConfiguration.Value == nil || *existingConfiguration.Value != value
+			}
+			if !changed {
+				continue
+			}
+
+			tflog.Trace(ctx, "Updating Extend app configuration via AccelByte API", map[string]interface{}{
+				"namespace":         namespace,
+				"name":              name,
+				"configurationType": configurationType,
+				"configName":        configName,
+			})
+
+			body := &csmclientmodels.ApimodelUpdateConfigurationV2Request{
+				ApplyMask: secrets,
+				Value:     &value,
+			}
+			if secrets {
+				_, err = r.configurationClient.UpdateSecretV2Short(&configuration_v2.UpdateSecretV2Params{
+					Namespace: namespace,
+					App:       name,
+					ConfigID:  *existingConfiguration.ConfigID,
+					Body:      body,
+				})
+			} else {
+				_, err = r.configurationClient.UpdateVariableV2Short(&configuration_v2.UpdateVariableV2Params{
+					Namespace: namespace,
+					App:       name,
+					ConfigID:  *existingConfiguration.ConfigID,
+					Body:      body,
+				})
+			}
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Error when updating Extend app %s via AccelByte API", configurationType), fmt.Sprintf("Unable to update %s '%s' for Extend app '%s' in namespace '%s', got error: %s", configurationType, configName, name, namespace, err))
+				return diags
+			}
+		} else {
+			tflog.Trace(ctx, "Creating Extend app configuration via AccelByte API", map[string]interface{}{
+				"namespace":         namespace,
+				"name":              name,
+				"configurationType": configurationType,
+				"configName":        configName,
+			})
+
+			configName := configName
+			source := "plaintext"
+			body := &csmclientmodels.ApimodelSaveConfigurationV2Request{
+				ApplyMask:  secrets,
+				ConfigName: &configName,
+				Source:     &source,
+				Value:      &value,
+			}
+			if secrets {
+				_, err = r.configurationClient.SaveSecretV2Short(&configuration_v2.SaveSecretV2Params{
+					Namespace: namespace,
+					App:       name,
+					Body:      body,
+				})
+			} else {
+				_, err = r.configurationClient.SaveVariableV2Short(&configuration_v2.SaveVariableV2Params{
+					Namespace: namespace,
+					App:       name,
+					Body:      body,
+				})
+			}
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Error when creating Extend app %s via AccelByte API", configurationType), fmt.Sprintf("Unable to create %s '%s' for Extend app '%s' in namespace '%s', got error: %s", configurationType, configName, name, namespace, err))
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
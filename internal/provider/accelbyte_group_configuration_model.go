@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/group-sdk/pkg/groupclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteGroupConfigurationModel is used by AccelByteGroupConfigurationResource.
+type AccelByteGroupConfigurationModel struct {
+	// Populated by user
+	Namespace         types.String `tfsdk:"namespace"`
+	ConfigurationCode types.String `tfsdk:"configuration_code"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Name              types.String `tfsdk:"name"`
+	GroupMaxMember    types.Int32  `tfsdk:"group_max_member"`
+	GroupAdminRoleId  types.String `tfsdk:"group_admin_role_id"`
+	GroupMemberRoleId types.String `tfsdk:"group_member_role_id"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Description   types.String `tfsdk:"description"`
+	AllowMultiple types.Bool   `tfsdk:"allow_multiple"`
+	GlobalRules   types.Set    `tfsdk:"global_rules"` // []AccelByteGroupConfigurationGlobalRuleModel
+}
+
+var AccelByteGroupConfigurationRuleDetailModelAttributeTypes = map[string]attr.Type{
+	"rule_attribute": types.StringType,
+	"rule_criteria":  types.StringType,
+	"rule_value":     types.Float64Type,
+}
+
+type AccelByteGroupConfigurationRuleDetailModel struct {
+	RuleAttribute types.String  `tfsdk:"rule_attribute"`
+	RuleCriteria  types.String  `tfsdk:"rule_criteria"`
+	RuleValue     types.Float64 `tfsdk:"rule_value"`
+}
+
+var AccelByteGroupConfigurationGlobalRuleModelAttributeTypes = map[string]attr.Type{
+	"allowed_action": types.StringType,
+	"rule_details":   types.ListType{ElemType: types.ObjectType{AttrTypes: AccelByteGroupConfigurationRuleDetailModelAttributeTypes}},
+}
+
+type AccelByteGroupConfigurationGlobalRuleModel struct {
+	AllowedAction types.String `tfsdk:"allowed_action"`
+	RuleDetails   types.List   `tfsdk:"rule_details"` // []AccelByteGroupConfigurationRuleDetailModel
+}
+
+// Used by Create, Read and Update operations on group configurations.
+// This copies data from the AccelByte API `configuration` to the TF state `data`.
+func updateFromApiGroupConfiguration(ctx context.Context, data *AccelByteGroupConfigurationModel, configuration *groupclientmodels.ModelsGetGroupConfigurationResponseV1) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.ConfigurationCode = types.StringValue(*configuration.ConfigurationCode)
+	data.Id = types.StringValue(computeGroupConfigurationId(data.Namespace.ValueString(), *configuration.ConfigurationCode))
+
+	data.Name = types.StringPointerValue(configuration.Name)
+	data.GroupMaxMember = types.Int32PointerValue(configuration.GroupMaxMember)
+	data.GroupAdminRoleId = types.StringPointerValue(configuration.GroupAdminRoleID)
+	data.GroupMemberRoleId = types.StringPointerValue(configuration.GroupMemberRoleID)
+
+	data.Description = types.StringPointerValue(configuration.Description)
+	if data.Description.IsNull() {
+		data.Description = types.StringValue("")
+	}
+	data.AllowMultiple = types.BoolValue(configuration.AllowMultiple != nil && *configuration.AllowMultiple)
+
+	globalRules := []AccelByteGroupConfigurationGlobalRuleModel{}
+	for _, globalRule := range configuration.GlobalRules {
+		ruleDetails := []AccelByteGroupConfigurationRuleDetailModel{}
+		for _, ruleDetail := range globalRule.RuleDetail {
+			ruleDetails = append(ruleDetails, AccelByteGroupConfigurationRuleDetailModel{
+				RuleAttribute: types.StringPointerValue(ruleDetail.RuleAttribute),
+				RuleCriteria:  types.StringPointerValue(ruleDetail.RuleCriteria),
+				RuleValue:     types.Float64PointerValue(ruleDetail.RuleValue),
+			})
+		}
+
+		ruleDetailsList, ruleDetailsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteGroupConfigurationRuleDetailModelAttributeTypes}, ruleDetails)
+		diags.Append(ruleDetailsDiags...)
+
+		globalRules = append(globalRules, AccelByteGroupConfigurationGlobalRuleModel{
+			AllowedAction: types.StringPointerValue(globalRule.AllowedAction),
+			RuleDetails:   ruleDetailsList,
+		})
+	}
+
+	globalRulesSet, globalRulesDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteGroupConfigurationGlobalRuleModelAttributeTypes}, globalRules)
+	data.GlobalRules = globalRulesSet
+	diags.Append(globalRulesDiags...)
+
+	return diags
+}
+
+// Used by Create/Update operations on group configurations.
+// This reads the global rules from the TF state `data` and returns them keyed by allowed action.
+func toApiGroupConfigurationGlobalRules(ctx context.Context, data AccelByteGroupConfigurationModel) (map[string][]*groupclientmodels.ModelsRuleInformation, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	globalRules := []AccelByteGroupConfigurationGlobalRuleModel{}
+	diags.Append(data.GlobalRules.ElementsAs(ctx, &globalRules, false)...)
+
+	apiGlobalRules := map[string][]*groupclientmodels.ModelsRuleInformation{}
+	for _, globalRule := range globalRules {
+		ruleDetails := []AccelByteGroupConfigurationRuleDetailModel{}
+		diags.Append(globalRule.RuleDetails.ElementsAs(ctx, &ruleDetails, false)...)
+
+		apiRuleDetails := []*groupclientmodels.ModelsRuleInformation{}
+		for _, ruleDetail := range ruleDetails {
+			apiRuleDetails = append(apiRuleDetails, &groupclientmodels.ModelsRuleInformation{
+				RuleAttribute: ruleDetail.RuleAttribute.ValueStringPointer(),
+				RuleCriteria:  ruleDetail.RuleCriteria.ValueStringPointer(),
+				RuleValue:     ruleDetail.RuleValue.ValueFloat64Pointer(),
+			})
+		}
+
+		apiGlobalRules[globalRule.AllowedAction.ValueString()] = apiRuleDetails
+	}
+
+	return apiGlobalRules, diags
+}
+
+// Used by the Create operation on group configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiGroupConfigurationCreate(ctx context.Context, data AccelByteGroupConfigurationModel) (*groupclientmodels.ModelsCreateGroupConfigurationRequestV1, diag.Diagnostics) {
+
+	apiGlobalRules, diags := toApiGroupConfigurationGlobalRules(ctx, data)
+
+	globalRules := []*groupclientmodels.ModelsRule{}
+	for allowedAction, ruleDetails := range apiGlobalRules {
+		allowedAction := allowedAction
+		globalRules = append(globalRules, &groupclientmodels.ModelsRule{
+			AllowedAction: &allowedAction,
+			RuleDetail:    ruleDetails,
+		})
+	}
+
+	return &groupclientmodels.ModelsCreateGroupConfigurationRequestV1{
+		ConfigurationCode: data.ConfigurationCode.ValueStringPointer(),
+		Name:              data.Name.ValueStringPointer(),
+		Description:       data.Description.ValueStringPointer(),
+		GroupMaxMember:    data.GroupMaxMember.ValueInt32Pointer(),
+		GroupAdminRoleID:  data.GroupAdminRoleId.ValueStringPointer(),
+		GroupMemberRoleID: data.GroupMemberRoleId.ValueStringPointer(),
+		AllowMultiple:     data.AllowMultiple.ValueBoolPointer(),
+		GlobalRules:       globalRules,
+	}, diags
+}
+
+// Used by the Update operation on group configurations.
+// Global rules are not included; these are managed via separate API calls.
+func toApiGroupConfigurationUpdate(data AccelByteGroupConfigurationModel) *groupclientmodels.ModelsUpdateGroupConfigurationRequestV1 {
+	return &groupclientmodels.ModelsUpdateGroupConfigurationRequestV1{
+		Name:           data.Name.ValueStringPointer(),
+		Description:    data.Description.ValueStringPointer(),
+		GroupMaxMember: data.GroupMaxMember.ValueInt32Pointer(),
+	}
+}
+
+func computeGroupConfigurationId(namespace string, configurationCode string) string {
+	return fmt.Sprintf("%s/%s", namespace, configurationCode)
+}
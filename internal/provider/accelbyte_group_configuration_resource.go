@@ -0,0 +1,476 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/AccelByte/accelbyte-go-sdk/group-sdk/pkg/groupclient/configuration"
+	"github.com/AccelByte/accelbyte-go-sdk/group-sdk/pkg/groupclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/group"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteGroupConfigurationResource{}
+var _ resource.ResourceWithImportState = &AccelByteGroupConfigurationResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteGroupConfigurationResource{}
+
+func NewAccelByteGroupConfigurationResource() resource.Resource {
+	return &AccelByteGroupConfigurationResource{}
+}
+
+// AccelByteGroupConfigurationResource defines the resource implementation.
+type AccelByteGroupConfigurationResource struct {
+	client *group.ConfigurationService
+}
+
+func (r *AccelByteGroupConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_configuration"
+}
+
+func (r *AccelByteGroupConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [group configuration](https://docs.accelbyte.io/gaming-services/services/social/group/). A group configuration defines the size of groups, the roles that their admins and members initially get, and the rules that players must satisfy to perform actions on groups. Groups are commonly used to implement clans or guilds.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the group configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configuration_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the group configuration. Changing this forces the group configuration to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Group configuration identifier, on the format `{{namespace}}/{{configuration_code}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the group configuration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"group_max_member": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of members in a group.",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"group_admin_role_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the member role that is assigned to the creator of a group. See the `accelbyte_group_member_role` resource. Changing this forces the group configuration to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_member_role_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the member role that is assigned to players who join a group. See the `accelbyte_group_member_role` resource. Changing this forces the group configuration to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the group configuration.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"allow_multiple": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players can be members of multiple groups at the same time. Changing this forces the group configuration to be re-created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"global_rules": schema.SetNestedAttribute{
+				MarkdownDescription: "Rules that players must satisfy to perform actions on groups, such as joining a group. Each allowed action can have at most one rule.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_action": schema.StringAttribute{
+							MarkdownDescription: "Group action that the rule applies to; for example `joinGroup`, `createGroup` or `inviteGroup`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"rule_details": schema.ListNestedAttribute{
+							MarkdownDescription: "Conditions on player statistics that must all be satisfied to perform the action.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"rule_attribute": schema.StringAttribute{
+										MarkdownDescription: "Code of the statistic that the condition applies to.",
+										Required:            true,
+									},
+									"rule_criteria": schema.StringAttribute{
+										MarkdownDescription: "How the statistic is compared against `rule_value`. Allowed values: `EQUAL`, `MINIMUM`, `MAXIMUM`.",
+										Required:            true,
+										Validators: []validator.String{
+											stringvalidator.OneOf(groupclientmodels.ModelsRuleInformationRuleCriteriaEQUAL, groupclientmodels.ModelsRuleInformationRuleCriteriaMINIMUM, groupclientmodels.ModelsRuleInformationRuleCriteriaMAXIMUM),
+										},
+									},
+									"rule_value": schema.Float64Attribute{
+										MarkdownDescription: "Value that the statistic is compared against.",
+										Required:            true,
+									},
+								},
+							},
+							Required: true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default:  setdefault.StaticValue(basetypes.NewSetValueMust(types.ObjectType{AttrTypes: AccelByteGroupConfigurationGlobalRuleModelAttributeTypes}, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *AccelByteGroupConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteGroupConfigurationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.GlobalRules.IsNull() || data.GlobalRules.IsUnknown() {
+		return
+	}
+
+	globalRules := []AccelByteGroupConfigurationGlobalRuleModel{}
+	resp.Diagnostics.Append(data.GlobalRules.ElementsAs(ctx, &globalRules, false)...)
+
+	allowedActions := map[string]bool{}
+	for _, globalRule := range globalRules {
+		if globalRule.AllowedAction.IsUnknown() {
+			continue
+		}
+		allowedAction := globalRule.AllowedAction.ValueString()
+		if allowedActions[allowedAction] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("global_rules"),
+				"Duplicate global rule",
+				fmt.Sprintf("Attribute `global_rules` contains more than one rule for allowed action '%s'", allowedAction),
+			)
+		}
+		allowedActions[allowedAction] = true
+	}
+}
+
+func (r *AccelByteGroupConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.GroupConfigurationService
+}
+
+func (r *AccelByteGroupConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteGroupConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiConfiguration, apiConfigurationDiags := toApiGroupConfigurationCreate(ctx, data)
+	resp.Diagnostics.Append(apiConfigurationDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating group configuration via AccelByte API", map[string]interface{}{
+		"namespace":         data.Namespace,
+		"configurationCode": data.ConfigurationCode,
+	})
+
+	input := &configuration.CreateGroupConfigurationAdminV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiConfiguration,
+	}
+
+	_, err := r.client.CreateGroupConfigurationAdminV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating group configuration via AccelByte API", fmt.Sprintf("Unable to create group configuration '%s' in namespace '%s', got error: %s", data.ConfigurationCode.ValueString(), input.Namespace, err))
+		return
+	}
+
+	// Fetch group configuration immediately after creating it; the create operation does not return the namespace
+
+	groupConfiguration, err := r.read(data.Namespace.ValueString(), data.ConfigurationCode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading group configuration via AccelByte API", fmt.Sprintf("Unable to read group configuration '%s' in namespace '%s', got error: %s", data.ConfigurationCode.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiGroupConfiguration(ctx, &data, groupConfiguration)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteGroupConfigurationResource) read(namespace string, configurationCode string) (*groupclientmodels.ModelsGetGroupConfigurationResponseV1, error) {
+	return r.client.GetGroupConfigurationAdminV1Short(&configuration.GetGroupConfigurationAdminV1Params{
+		Namespace:         namespace,
+		ConfigurationCode: configurationCode,
+	})
+}
+
+func (r *AccelByteGroupConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteGroupConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupConfiguration, err := r.read(data.Namespace.ValueString(), data.ConfigurationCode.ValueString())
+	if err != nil {
+		notFoundError := &configuration.GetGroupConfigurationAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading group configuration via AccelByte API", fmt.Sprintf("Unable to read group configuration '%s' in namespace '%s', got error: %s", data.ConfigurationCode.ValueString(), data.Namespace.ValueString(), err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read group configuration from AccelByte API", map[string]interface{}{
+		"namespace":          data.Namespace,
+		"configurationCode":  data.ConfigurationCode,
+		"groupConfiguration": groupConfiguration,
+	})
+
+	resp.Diagnostics.Append(updateFromApiGroupConfiguration(ctx, &data, groupConfiguration)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteGroupConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteGroupConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating group configuration via AccelByte API", map[string]interface{}{
+		"namespace":         data.Namespace,
+		"configurationCode": data.ConfigurationCode,
+	})
+
+	input := &configuration.UpdateGroupConfigurationAdminV1Params{
+		Namespace:         data.Namespace.ValueString(),
+		ConfigurationCode: data.ConfigurationCode.ValueString(),
+		Body:              toApiGroupConfigurationUpdate(data),
+	}
+
+	updatedConfiguration, err := r.client.UpdateGroupConfigurationAdminV1Short(input)
+	if err != nil {
+		notFoundError := &configuration.UpdateGroupConfigurationAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Group configuration '%s' does not exist in namespace '%s'", input.ConfigurationCode, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating group configuration via AccelByte API", fmt.Sprintf("Unable to update group configuration '%s' in namespace '%s', got error: %s", input.ConfigurationCode, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.applyGlobalRules(ctx, data, updatedConfiguration.GlobalRules)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groupConfiguration, err := r.read(data.Namespace.ValueString(), data.ConfigurationCode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading group configuration via AccelByte API", fmt.Sprintf("Unable to read group configuration '%s' in namespace '%s', got error: %s", data.ConfigurationCode.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiGroupConfiguration(ctx, &data, groupConfiguration)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Global rules are managed one allowed action at a time.
+// This deletes the rules which are no longer desired, and creates or updates the rules which have changed.
+func (r *AccelByteGroupConfigurationResource) applyGlobalRules(ctx context.Context, data AccelByteGroupConfigurationModel, currentGlobalRules []*groupclientmodels.ModelsRuleResponseV1) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	namespace := data.Namespace.ValueString()
+	configurationCode := data.ConfigurationCode.ValueString()
+
+	desiredGlobalRules, desiredGlobalRulesDiags := toApiGroupConfigurationGlobalRules(ctx, data)
+	diags.Append(desiredGlobalRulesDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	currentGlobalRulesByAction := map[string][]*groupclientmodels.ModelsRuleInformation{}
+	for _, globalRule := range currentGlobalRules {
+		currentGlobalRulesByAction[*globalRule.AllowedAction] = globalRule.RuleDetail
+	}
+
+	for allowedAction := range currentGlobalRulesByAction {
+		if _, ok := desiredGlobalRules[allowedAction]; ok {
+			continue
+		}
+
+		_, err := r.client.DeleteGroupConfigurationGlobalRuleAdminV1Short(&configuration.DeleteGroupConfigurationGlobalRuleAdminV1Params{
+			Namespace:         namespace,
+			ConfigurationCode: configurationCode,
+			AllowedAction:     allowedAction,
+		})
+		if err != nil {
+			notFoundError := &configuration.DeleteGroupConfigurationGlobalRuleAdminV1NotFound{}
+			if errors.As(err, &notFoundError) {
+				// The rule has already been deleted from the AccelByte backend
+				continue
+			}
+			diags.AddError("Error when deleting group configuration global rule via AccelByte API", fmt.Sprintf("Unable to delete global rule '%s' from group configuration '%s' in namespace '%s', got error: %s", allowedAction, configurationCode, namespace, err))
+			return diags
+		}
+	}
+
+	for allowedAction, desiredRuleDetails := range desiredGlobalRules {
+		if currentRuleDetails, ok := currentGlobalRulesByAction[allowedAction]; ok && reflect.DeepEqual(currentRuleDetails, desiredRuleDetails) {
+			continue
+		}
+
+		_, err := r.client.UpdateGroupConfigurationGlobalRuleAdminV1Short(&configuration.UpdateGroupConfigurationGlobalRuleAdminV1Params{
+			Namespace:         namespace,
+			ConfigurationCode: configurationCode,
+			AllowedAction:     allowedAction,
+			Body: &groupclientmodels.ModelsUpdateGroupConfigurationGlobalRulesRequestV1{
+				RuleDetail: desiredRuleDetails,
+			},
+		})
+		if err != nil {
+			diags.AddError("Error when updating group configuration global rule via AccelByte API", fmt.Sprintf("Unable to update global rule '%s' for group configuration '%s' in namespace '%s', got error: %s", allowedAction, configurationCode, namespace, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *AccelByteGroupConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteGroupConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting group configuration via AccelByte API", map[string]interface{}{
+		"namespace":         data.Namespace,
+		"configurationCode": data.ConfigurationCode,
+	})
+
+	input := &configuration.DeleteGroupConfigurationV1Params{
+		Namespace:         data.Namespace.ValueString(),
+		ConfigurationCode: data.ConfigurationCode.ValueString(),
+	}
+	err := r.client.DeleteGroupConfigurationV1Short(input)
+	if err != nil {
+		notFoundError := &configuration.DeleteGroupConfigurationV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting group configuration via AccelByte API", fmt.Sprintf("Unable to delete group configuration '%s' in namespace '%s', got error: %s", input.ConfigurationCode, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteGroupConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "configuration_code")
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/group-sdk/pkg/groupclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteGroupMemberRoleModel is used by AccelByteGroupMemberRoleResource.
+type AccelByteGroupMemberRoleModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	MemberRoleId types.String `tfsdk:"member_role_id"`
+	Id           types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	MemberRoleName types.String `tfsdk:"member_role_name"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	MemberRolePermissions types.Set `tfsdk:"member_role_permissions"` // []AccelByteGroupMemberRolePermissionModel
+}
+
+var AccelByteGroupMemberRolePermissionModelAttributeTypes = map[string]attr.Type{
+	"resource_name": types.StringType,
+	"action":        types.Int32Type,
+}
+
+type AccelByteGroupMemberRolePermissionModel struct {
+	ResourceName types.String `tfsdk:"resource_name"`
+	Action       types.Int32  `tfsdk:"action"`
+}
+
+// Used by Create, Read and Update operations on group member roles.
+// This copies data from the AccelByte API `memberRole` to the TF state `data`.
+func updateFromApiGroupMemberRole(ctx context.Context, data *AccelByteGroupMemberRoleModel, memberRole *groupclientmodels.ModelsMemberRoleResponseV1) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.MemberRoleId = types.StringValue(*memberRole.MemberRoleID)
+	data.Id = types.StringValue(computeGroupMemberRoleId(data.Namespace.ValueString(), *memberRole.MemberRoleID))
+
+	data.MemberRoleName = types.StringPointerValue(memberRole.MemberRoleName)
+
+	permissions := []AccelByteGroupMemberRolePermissionModel{}
+	for _, permission := range memberRole.MemberRolePermissions {
+		permissions = append(permissions, AccelByteGroupMemberRolePermissionModel{
+			ResourceName: types.StringPointerValue(permission.ResourceName),
+			Action:       types.Int32PointerValue(permission.Action),
+		})
+	}
+
+	permissionsSet, permissionsDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteGroupMemberRolePermissionModelAttributeTypes}, permissions)
+	data.MemberRolePermissions = permissionsSet
+	diags.Append(permissionsDiags...)
+
+	return diags
+}
+
+// Used by Create/Update operations on group member roles.
+// This reads the permissions from the TF state `data` and returns them as AccelByte API objects.
+func toApiGroupMemberRolePermissions(ctx context.Context, data AccelByteGroupMemberRoleModel) ([]*groupclientmodels.ModelsRolePermission, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	permissions := []AccelByteGroupMemberRolePermissionModel{}
+	diags.Append(data.MemberRolePermissions.ElementsAs(ctx, &permissions, false)...)
+
+	apiPermissions := []*groupclientmodels.ModelsRolePermission{}
+	for _, permission := range permissions {
+		apiPermissions = append(apiPermissions, &groupclientmodels.ModelsRolePermission{
+			ResourceName: permission.ResourceName.ValueStringPointer(),
+			Action:       permission.Action.ValueInt32Pointer(),
+		})
+	}
+
+	return apiPermissions, diags
+}
+
+func computeGroupMemberRoleId(namespace string, memberRoleId string) string {
+	return fmt.Sprintf("%s/%s", namespace, memberRoleId)
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/group-sdk/pkg/groupclient/group_roles"
+	"github.com/AccelByte/accelbyte-go-sdk/group-sdk/pkg/groupclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/group"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteGroupMemberRoleResource{}
+var _ resource.ResourceWithImportState = &AccelByteGroupMemberRoleResource{}
+
+func NewAccelByteGroupMemberRoleResource() resource.Resource {
+	return &AccelByteGroupMemberRoleResource{}
+}
+
+// AccelByteGroupMemberRoleResource defines the resource implementation.
+type AccelByteGroupMemberRoleResource struct {
+	client *group.GroupRolesService
+}
+
+func (r *AccelByteGroupMemberRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_member_role"
+}
+
+func (r *AccelByteGroupMemberRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [group member role](https://docs.accelbyte.io/gaming-services/services/social/group/). Member roles control what members of a group are allowed to do within the group. A group configuration refers to the roles that are given to the group's admin and to new members.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the member role. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"member_role_id": schema.StringAttribute{
+				MarkdownDescription: "Member role ID, as assigned by the Group service when the role was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Member role identifier, on the format `{{namespace}}/{{member_role_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"member_role_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the member role.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"member_role_permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "Permissions granted by the member role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_name": schema.StringAttribute{
+							MarkdownDescription: "Resource that the permission applies to, for example `GROUP:INVITE` or `GROUP:KICK`.",
+							Required:            true,
+						},
+						"action": schema.Int32Attribute{
+							MarkdownDescription: "Bitmask of actions that are permitted on the resource. 1 = Create, 2 = Read, 4 = Update, 8 = Delete.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.Between(0, 15),
+							},
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default:  setdefault.StaticValue(basetypes.NewSetValueMust(types.ObjectType{AttrTypes: AccelByteGroupMemberRolePermissionModelAttributeTypes}, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *AccelByteGroupMemberRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.GroupRolesService
+}
+
+func (r *AccelByteGroupMemberRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteGroupMemberRoleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, permissionsDiags := toApiGroupMemberRolePermissions(ctx, data)
+	resp.Diagnostics.Append(permissionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating group member role via AccelByte API", map[string]interface{}{
+		"namespace":      data.Namespace,
+		"memberRoleName": data.MemberRoleName,
+	})
+
+	input := &group_roles.CreateMemberRoleAdminV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body: &groupclientmodels.ModelsCreateMemberRoleRequestV1{
+			MemberRoleName:        data.MemberRoleName.ValueStringPointer(),
+			MemberRolePermissions: permissions,
+		},
+	}
+
+	memberRole, err := r.client.CreateMemberRoleAdminV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating group member role via AccelByte API", fmt.Sprintf("Unable to create group member role '%s' in namespace '%s', got error: %s", data.MemberRoleName.ValueString(), input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiGroupMemberRole(ctx, &data, memberRole)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteGroupMemberRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteGroupMemberRoleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &group_roles.GetSingleMemberRoleAdminV1Params{
+		Namespace:    data.Namespace.ValueString(),
+		MemberRoleID: data.MemberRoleId.ValueString(),
+	}
+	memberRole, err := r.client.GetSingleMemberRoleAdminV1Short(input)
+	if err != nil {
+		notFoundError := &group_roles.GetSingleMemberRoleAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading group member role via AccelByte API", fmt.Sprintf("Unable to read group member role '%s' in namespace '%s', got error: %s", input.MemberRoleID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read group member role from AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"memberRoleId": data.MemberRoleId,
+		"memberRole":   memberRole,
+	})
+
+	resp.Diagnostics.Append(updateFromApiGroupMemberRole(ctx, &data, memberRole)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteGroupMemberRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteGroupMemberRoleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, permissionsDiags := toApiGroupMemberRolePermissions(ctx, data)
+	resp.Diagnostics.Append(permissionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating group member role via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"memberRoleId": data.MemberRoleId,
+	})
+
+	namespace := data.Namespace.ValueString()
+	memberRoleId := data.MemberRoleId.ValueString()
+
+	// The name and the permissions of a member role are updated via separate API calls
+
+	_, err := r.client.UpdateMemberRoleAdminV1Short(&group_roles.UpdateMemberRoleAdminV1Params{
+		Namespace:    namespace,
+		MemberRoleID: memberRoleId,
+		Body: &groupclientmodels.ModelsUpdateMemberRoleRequestV1{
+			MemberRoleName: data.MemberRoleName.ValueStringPointer(),
+		},
+	})
+	if err != nil {
+		notFoundError := &group_roles.UpdateMemberRoleAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Group member role '%s' does not exist in namespace '%s'", memberRoleId, namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating group member role via AccelByte API", fmt.Sprintf("Unable to update group member role '%s' in namespace '%s', got error: %s", memberRoleId, namespace, err))
+			return
+		}
+	}
+
+	memberRole, err := r.client.UpdateMemberRolePermissionAdminV1Short(&group_roles.UpdateMemberRolePermissionAdminV1Params{
+		Namespace:    namespace,
+		MemberRoleID: memberRoleId,
+		Body: &groupclientmodels.ModelsUpdateMemberRolePermissionsRequestV1{
+			MemberRolePermissions: permissions,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error when updating group member role permissions via AccelByte API", fmt.Sprintf("Unable to update permissions of group member role '%s' in namespace '%s', got error: %s", memberRoleId, namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiGroupMemberRole(ctx, &data, memberRole)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteGroupMemberRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteGroupMemberRoleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting group member role via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"memberRoleId": data.MemberRoleId,
+	})
+
+	input := &group_roles.DeleteMemberRoleAdminV1Params{
+		Namespace:    data.Namespace.ValueString(),
+		MemberRoleID: data.MemberRoleId.ValueString(),
+	}
+	err := r.client.DeleteMemberRoleAdminV1Short(input)
+	if err != nil {
+		notFoundError := &group_roles.DeleteMemberRoleAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting group member role via AccelByte API", fmt.Sprintf("Unable to delete group member role '%s' in namespace '%s', got error: %s", input.MemberRoleID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteGroupMemberRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "member_role_id")
+}
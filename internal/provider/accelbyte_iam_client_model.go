@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteIamClientModel is used by AccelByteIamClientResource.
+type AccelByteIamClientModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Can be set by user during resource creation; will otherwise be generated during Create() operation
+	ClientId types.String `tfsdk:"client_id"`
+
+	// Computed during Create() operation
+	Id           types.String `tfsdk:"id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+
+	// Must be set by user during resource creation
+	Name       types.String `tfsdk:"name"`
+	ClientType types.String `tfsdk:"client_type"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or from the API
+	Description                         types.String `tfsdk:"description"`
+	ClientPlatform                      types.String `tfsdk:"client_platform"`
+	RedirectUri                         types.String `tfsdk:"redirect_uri"`
+	BaseUri                             types.String `tfsdk:"base_uri"`
+	Scopes                              types.List   `tfsdk:"scopes"`      // []string
+	Audiences                           types.List   `tfsdk:"audiences"`   // []string
+	Permissions                         types.Set    `tfsdk:"permissions"` // []AccelByteIamClientPermissionModel
+	OauthAccessTokenExpiration          types.Int32  `tfsdk:"oauth_access_token_expiration"`
+	OauthAccessTokenExpirationTimeUnit  types.String `tfsdk:"oauth_access_token_expiration_time_unit"`
+	OauthRefreshTokenExpiration         types.Int32  `tfsdk:"oauth_refresh_token_expiration"`
+	OauthRefreshTokenExpirationTimeUnit types.String `tfsdk:"oauth_refresh_token_expiration_time_unit"`
+	SkipLoginQueue                      types.Bool   `tfsdk:"skip_login_queue"`
+	TwoFactorEnabled                    types.Bool   `tfsdk:"two_factor_enabled"`
+}
+
+var AccelByteIamClientPermissionModelAttributeTypes = map[string]attr.Type{
+	"resource": types.StringType,
+	"action":   types.Int32Type,
+}
+
+type AccelByteIamClientPermissionModel struct {
+	Resource types.String `tfsdk:"resource"`
+	Action   types.Int32  `tfsdk:"action"`
+}
+
+// Used by Create, Read and Update operations on IAM clients.
+// This copies data from the AccelByte API `client` to the TF state `data`.
+// The client secret is never returned by the API, so it is left untouched.
+func updateFromApiIamClient(ctx context.Context, data *AccelByteIamClientModel, client *iamclientmodels.ClientmodelClientV3Response) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.ClientId = types.StringValue(*client.ClientID)
+	data.Id = types.StringValue(computeIamClientId(data.Namespace.ValueString(), *client.ClientID))
+
+	data.Name = types.StringValue(*client.ClientName)
+	data.ClientType = types.StringValue(*client.OauthClientType)
+	data.Description = types.StringPointerValue(client.Description)
+	data.ClientPlatform = types.StringPointerValue(client.ClientPlatform)
+	data.RedirectUri = types.StringPointerValue(client.RedirectURI)
+	data.BaseUri = types.StringPointerValue(client.BaseURI)
+
+	scopes, scopesDiags := listValueFromEvenIfNil(ctx, types.StringType, client.Scopes)
+	data.Scopes = scopes
+	diags.Append(scopesDiags...)
+
+	audiences, audiencesDiags := listValueFromEvenIfNil(ctx, types.StringType, client.Audiences)
+	data.Audiences = audiences
+	diags.Append(audiencesDiags...)
+
+	permissions := []AccelByteIamClientPermissionModel{}
+	for _, permission := range client.ClientPermissions {
+		permissions = append(permissions, AccelByteIamClientPermissionModel{
+			Resource: types.StringValue(*permission.Resource),
+			Action:   types.Int32Value(*permission.Action),
+		})
+	}
+	permissionsSet, permissionsDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteIamClientPermissionModelAttributeTypes}, permissions)
+	data.Permissions = permissionsSet
+	diags.Append(permissionsDiags...)
+
+	data.OauthAccessTokenExpiration = types.Int32Value(client.OauthAccessTokenExpiration)
+	data.OauthAccessTokenExpirationTimeUnit = types.StringValue(client.OauthAccessTokenExpirationTimeUnit)
+	data.OauthRefreshTokenExpiration = types.Int32Value(client.OauthRefreshTokenExpiration)
+	data.OauthRefreshTokenExpirationTimeUnit = types.StringValue(client.OauthRefreshTokenExpirationTimeUnit)
+
+	data.SkipLoginQueue = types.BoolValue(client.SkipLoginQueue != nil && *client.SkipLoginQueue)
+	data.TwoFactorEnabled = types.BoolValue(client.TwoFactorEnabled != nil && *client.TwoFactorEnabled)
+
+	return diags
+}
+
+// Used by Create/Update operations on IAM clients.
+// This reads from the TF state `data` and returns a list of AccelByte API permissions.
+func toApiIamClientPermissions(ctx context.Context, data AccelByteIamClientModel) ([]*iamclientmodels.AccountcommonPermissionV3, diag.Diagnostics) {
+
+	permissions := []AccelByteIamClientPermissionModel{}
+	diags := data.Permissions.ElementsAs(ctx, &permissions, false)
+
+	apiPermissions := []*iamclientmodels.AccountcommonPermissionV3{}
+	for _, permission := range permissions {
+		apiPermissions = append(apiPermissions, &iamclientmodels.AccountcommonPermissionV3{
+			Resource: permission.Resource.ValueStringPointer(),
+			Action:   permission.Action.ValueInt32Pointer(),
+		})
+	}
+
+	return apiPermissions, diags
+}
+
+// Used by the Create operation on IAM clients.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Settings that are not known at this point are omitted, so that the API applies its defaults.
+func toApiIamClientCreationRequest(ctx context.Context, data AccelByteIamClientModel) (*iamclientmodels.ClientmodelClientCreationV3Request, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	permissions, permissionsDiags := toApiIamClientPermissions(ctx, data)
+	diags.Append(permissionsDiags...)
+
+	request := &iamclientmodels.ClientmodelClientCreationV3Request{
+		Namespace:         data.Namespace.ValueStringPointer(),
+		ClientID:          data.ClientId.ValueStringPointer(),
+		ClientName:        data.Name.ValueStringPointer(),
+		OauthClientType:   data.ClientType.ValueStringPointer(),
+		Description:       data.Description.ValueStringPointer(),
+		ClientPlatform:    data.ClientPlatform.ValueStringPointer(),
+		RedirectURI:       data.RedirectUri.ValueStringPointer(),
+		BaseURI:           data.BaseUri.ValueStringPointer(),
+		ClientPermissions: permissions,
+		SkipLoginQueue:    data.SkipLoginQueue.ValueBoolPointer(),
+		TwoFactorEnabled:  data.TwoFactorEnabled.ValueBool(),
+		// Clients created by Terraform must also be deletable by Terraform
+		Deletable: true,
+		Audiences: []string{},
+	}
+
+	if !data.ClientSecret.IsNull() && !data.ClientSecret.IsUnknown() {
+		request.Secret = data.ClientSecret.ValueStringPointer()
+	} else {
+		// Public clients do not have a secret
+		request.Secret = new(string)
+	}
+
+	if !data.Scopes.IsUnknown() {
+		diags.Append(data.Scopes.ElementsAs(ctx, &request.Scopes, false)...)
+	}
+	if !data.Audiences.IsUnknown() {
+		diags.Append(data.Audiences.ElementsAs(ctx, &request.Audiences, false)...)
+	}
+
+	if !data.OauthAccessTokenExpiration.IsUnknown() {
+		request.OauthAccessTokenExpiration = data.OauthAccessTokenExpiration.ValueInt32()
+	}
+	if !data.OauthAccessTokenExpirationTimeUnit.IsUnknown() {
+		request.OauthAccessTokenExpirationTimeUnit = data.OauthAccessTokenExpirationTimeUnit.ValueString()
+	}
+	if !data.OauthRefreshTokenExpiration.IsUnknown() {
+		request.OauthRefreshTokenExpiration = data.OauthRefreshTokenExpiration.ValueInt32()
+	}
+	if !data.OauthRefreshTokenExpirationTimeUnit.IsUnknown() {
+		request.OauthRefreshTokenExpirationTimeUnit = data.OauthRefreshTokenExpirationTimeUnit.ValueString()
+	}
+
+	return request, diags
+}
+
+// Used by the Update operation on IAM clients.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Permissions are not included; they are replaced via a separate API call, since the update API cannot clear them.
+func toApiIamClientUpdateRequest(ctx context.Context, data AccelByteIamClientModel) (*iamclientmodels.ClientmodelClientUpdateV3Request, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	request := &iamclientmodels.ClientmodelClientUpdateV3Request{
+		Namespace:                           data.Namespace.ValueString(),
+		ClientName:                          data.Name.ValueString(),
+		Description:                         data.Description.ValueStringPointer(),
+		ClientPlatform:                      data.ClientPlatform.ValueStringPointer(),
+		RedirectURI:                         data.RedirectUri.ValueString(),
+		BaseURI:                             data.BaseUri.ValueString(),
+		OauthAccessTokenExpiration:          data.OauthAccessTokenExpiration.ValueInt32(),
+		OauthAccessTokenExpirationTimeUnit:  data.OauthAccessTokenExpirationTimeUnit.ValueString(),
+		OauthRefreshTokenExpiration:         data.OauthRefreshTokenExpiration.ValueInt32(),
+		OauthRefreshTokenExpirationTimeUnit: data.OauthRefreshTokenExpirationTimeUnit.ValueString(),
+		SkipLoginQueue:                      data.SkipLoginQueue.ValueBool(),
+		TwoFactorEnabled:                    data.TwoFactorEnabled.ValueBool(),
+		Deletable:                           true,
+	}
+
+	diags.Append(data.Scopes.ElementsAs(ctx, &request.Scopes, false)...)
+	diags.Append(data.Audiences.ElementsAs(ctx, &request.Audiences, false)...)
+
+	return request, diags
+}
+
+// Generates a client ID on the format expected by the IAM API: a version 4 UUID without hyphens.
+func generateIamClientId() (string, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	id[6] = (id[6] & 0x0f) | 0x40 // Version 4
+	id[8] = (id[8] & 0x3f) | 0x80 // Variant 10
+	return hex.EncodeToString(id), nil
+}
+
+// Generates a random client secret.
+func generateIamClientSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func computeIamClientId(namespace string, clientId string) string {
+	return fmt.Sprintf("%s/%s", namespace, clientId)
+}
@@ -0,0 +1,29 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestGenerateIamClientId(t *testing.T) {
+	// 32 lowercase hex digits, with the version 4 and variant 10 bits of a UUID set
+	format := regexp.MustCompile(`^[0-9a-f]{12}4[0-9a-f]{3}[89ab][0-9a-f]{15}$`)
+
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		id, err := generateIamClientId()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if !format.MatchString(id) {
+			t.Fatalf("got client ID '%s', which is not a dash-less UUID v4", id)
+		}
+		if seen[id] {
+			t.Fatalf("got client ID '%s' twice", id)
+		}
+		seen[id] = true
+	}
+}
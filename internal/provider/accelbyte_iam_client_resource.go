@@ -0,0 +1,507 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclient/clients"
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteIamClientResource{}
+var _ resource.ResourceWithImportState = &AccelByteIamClientResource{}
+
+func NewAccelByteIamClientResource() resource.Resource {
+	return &AccelByteIamClientResource{}
+}
+
+// AccelByteIamClientResource defines the resource implementation.
+type AccelByteIamClientResource struct {
+	client *iam.ClientsService
+}
+
+func (r *AccelByteIamClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_client"
+}
+
+func (r *AccelByteIamClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an [IAM OAuth client](https://docs.accelbyte.io/gaming-services/services/access/authorization/manage-access-control-for-applications/).",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from this and the client ID
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace which contains the client. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise be generated during Create() operation
+
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID. A version 4 UUID without hyphens. If not set, a random client ID will be generated.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[0-9a-f]{32}$`), "must be a version 4 UUID without hyphens, in lowercase"),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "IAM client identifier, on the format `{{namespace}}/{{client_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret. Generated when a `Confidential` client is created; null for `Public` clients and for clients that have been imported, since the AccelByte API never returns client secrets.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of client.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_type": schema.StringAttribute{
+				MarkdownDescription: "Type of OAuth client. Either `Confidential` (for game servers and backend services, which can keep a secret) or `Public` (for game clients). Changing this forces the client to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("Confidential", "Public"),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of client.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"client_platform": schema.StringAttribute{
+				MarkdownDescription: "Platform that the client is used on, if any.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"redirect_uri": schema.StringAttribute{
+				MarkdownDescription: "Redirect URI(s) used during OAuth authorization code flows. Multiple URIs are comma-separated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"base_uri": schema.StringAttribute{
+				MarkdownDescription: "Base URI of the client's web application, if any.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "Permissions granted to the client.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource": schema.StringAttribute{
+							MarkdownDescription: "Resource that the permission applies to, for example `ADMIN:NAMESPACE:{namespace}:SESSION:CONFIGURATION`.",
+							Required:            true,
+						},
+						"action": schema.Int32Attribute{
+							MarkdownDescription: "Bitmask of actions that are permitted on the resource. 1 = Create, 2 = Read, 4 = Update, 8 = Delete.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.Between(0, 15),
+							},
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default:  setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: AccelByteIamClientPermissionModelAttributeTypes}, []attr.Value{})),
+			},
+			"skip_login_queue": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, users logging in via this client will bypass the login queue.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"two_factor_enabled": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, users logging in via this client must use two-factor authentication.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from the API
+
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "OAuth scopes that the client can request. Once set, scopes can be changed but cannot be cleared.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audiences": schema.ListAttribute{
+				MarkdownDescription: "Audiences that access tokens issued to the client are valid for. Once set, audiences can be changed but cannot be cleared.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"oauth_access_token_expiration": schema.Int32Attribute{
+				MarkdownDescription: "Lifetime of access tokens issued to the client, in units of `oauth_access_token_expiration_time_unit`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"oauth_access_token_expiration_time_unit": schema.StringAttribute{
+				MarkdownDescription: "Time unit for `oauth_access_token_expiration`. One of `SECONDS`, `MINUTES`, `HOURS` or `DAYS`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("SECONDS", "MINUTES", "HOURS", "DAYS"),
+				},
+			},
+			"oauth_refresh_token_expiration": schema.Int32Attribute{
+				MarkdownDescription: "Lifetime of refresh tokens issued to the client, in units of `oauth_refresh_token_expiration_time_unit`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"oauth_refresh_token_expiration_time_unit": schema.StringAttribute{
+				MarkdownDescription: "Time unit for `oauth_refresh_token_expiration`. One of `SECONDS`, `MINUTES`, `HOURS` or `DAYS`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("SECONDS", "MINUTES", "HOURS", "DAYS"),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteIamClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.IamClientsService
+}
+
+func (r *AccelByteIamClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteIamClientModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The IAM API expects the caller to choose the client ID and secret
+
+	if data.ClientId.IsUnknown() || data.ClientId.IsNull() {
+		clientId, err := generateIamClientId()
+		if err != nil {
+			resp.Diagnostics.AddError("Error when generating IAM client ID", fmt.Sprintf("Unable to generate client ID, got error: %s", err))
+			return
+		}
+		data.ClientId = types.StringValue(clientId)
+	}
+
+	if data.ClientType.ValueString() == "Confidential" {
+		clientSecret, err := generateIamClientSecret()
+		if err != nil {
+			resp.Diagnostics.AddError("Error when generating IAM client secret", fmt.Sprintf("Unable to generate client secret, got error: %s", err))
+			return
+		}
+		data.ClientSecret = types.StringValue(clientSecret)
+	} else {
+		data.ClientSecret = types.StringNull()
+	}
+
+	apiCreationRequest, apiCreationRequestDiags := toApiIamClientCreationRequest(ctx, data)
+	resp.Diagnostics.Append(apiCreationRequestDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating IAM client via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"clientId":  data.ClientId,
+		"name":      data.Name,
+	})
+
+	createInput := &clients.AdminCreateClientV3Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiCreationRequest,
+	}
+
+	_, err := r.client.AdminCreateClientV3Short(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating IAM client via AccelByte API", fmt.Sprintf("Unable to create IAM client '%s' in namespace '%s', got error: %s", *createInput.Body.ClientID, createInput.Namespace, err))
+		return
+	}
+
+	// Fetch client immediately after creating it, so we can get the values for un-set defaults
+
+	readInput := &clients.AdminGetClientsbyNamespacebyIDV3Params{
+		Namespace: data.Namespace.ValueString(),
+		ClientID:  data.ClientId.ValueString(),
+	}
+
+	client, err := r.client.AdminGetClientsbyNamespacebyIDV3Short(readInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading IAM client via AccelByte API", fmt.Sprintf("Unable to read IAM client '%s' in namespace '%s', got error: %s", readInput.ClientID, readInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiIamClient(ctx, &data, client)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteIamClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteIamClientModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &clients.AdminGetClientsbyNamespacebyIDV3Params{
+		Namespace: data.Namespace.ValueString(),
+		ClientID:  data.ClientId.ValueString(),
+	}
+
+	client, err := r.client.AdminGetClientsbyNamespacebyIDV3Short(input)
+	if err != nil {
+		notFoundError := &clients.AdminGetClientsbyNamespacebyIDV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading IAM client via AccelByte API", fmt.Sprintf("Unable to read IAM client '%s' in namespace '%s', got error: %s", input.ClientID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read IAM client from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"clientId":  data.ClientId,
+		"name":      client.ClientName,
+	})
+
+	resp.Diagnostics.Append(updateFromApiIamClient(ctx, &data, client)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteIamClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteIamClientModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiUpdateRequest, apiUpdateRequestDiags := toApiIamClientUpdateRequest(ctx, data)
+	resp.Diagnostics.Append(apiUpdateRequestDiags...)
+	apiPermissions, apiPermissionsDiags := toApiIamClientPermissions(ctx, data)
+	resp.Diagnostics.Append(apiPermissionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating IAM client via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"clientId":  data.ClientId,
+		"name":      data.Name,
+	})
+
+	input := &clients.AdminUpdateClientV3Params{
+		Namespace: data.Namespace.ValueString(),
+		ClientID:  data.ClientId.ValueString(),
+		Body:      apiUpdateRequest,
+	}
+
+	_, err := r.client.AdminUpdateClientV3Short(input)
+	if err != nil {
+		notFoundError := &clients.AdminUpdateClientV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("IAM client '%s' does not exist in namespace '%s'", input.ClientID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating IAM client via AccelByte API", fmt.Sprintf("Unable to update IAM client '%s' in namespace '%s', got error: %s", input.ClientID, input.Namespace, err))
+			return
+		}
+	}
+
+	// The update API cannot remove all permissions from a client; replace the permission list separately
+
+	clientPermissions := []*iamclientmodels.AccountcommonClientPermissionV3{}
+	for _, permission := range apiPermissions {
+		clientPermissions = append(clientPermissions, &iamclientmodels.AccountcommonClientPermissionV3{
+			Resource: permission.Resource,
+			Action:   permission.Action,
+		})
+	}
+
+	permissionsInput := &clients.AdminUpdateClientPermissionV3Params{
+		Namespace: data.Namespace.ValueString(),
+		ClientID:  data.ClientId.ValueString(),
+		Body: &iamclientmodels.AccountcommonClientPermissionsV3{
+			Permissions: clientPermissions,
+		},
+	}
+
+	err = r.client.AdminUpdateClientPermissionV3Short(permissionsInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when updating IAM client permissions via AccelByte API", fmt.Sprintf("Unable to update permissions for IAM client '%s' in namespace '%s', got error: %s", permissionsInput.ClientID, permissionsInput.Namespace, err))
+		return
+	}
+
+	// Fetch client after updating it, so we can get the values for un-set defaults
+
+	readInput := &clients.AdminGetClientsbyNamespacebyIDV3Params{
+		Namespace: data.Namespace.ValueString(),
+		ClientID:  data.ClientId.ValueString(),
+	}
+
+	client, err := r.client.AdminGetClientsbyNamespacebyIDV3Short(readInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading IAM client via AccelByte API", fmt.Sprintf("Unable to read IAM client '%s' in namespace '%s', got error: %s", readInput.ClientID, readInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiIamClient(ctx, &data, client)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteIamClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteIamClientModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting IAM client via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"clientId":  data.ClientId,
+	})
+
+	input := &clients.AdminDeleteClientV3Params{
+		Namespace: data.Namespace.ValueString(),
+		ClientID:  data.ClientId.ValueString(),
+	}
+	err := r.client.AdminDeleteClientV3Short(input)
+	if err != nil {
+		notFoundError := &clients.AdminDeleteClientV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting IAM client via AccelByte API", fmt.Sprintf("Unable to delete IAM client '%s' in namespace '%s', got error: %s", input.ClientID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteIamClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "client_id")
+}
@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteIamRoleModel is used by AccelByteIamRoleResource.
+type AccelByteIamRoleModel struct {
+	// Computed during Create() operation
+	Id     types.String `tfsdk:"id"`
+	RoleId types.String `tfsdk:"role_id"`
+
+	// Must be set by user during resource creation
+	Name types.String `tfsdk:"name"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	AdminRole   types.Bool `tfsdk:"admin_role"`
+	IsWildcard  types.Bool `tfsdk:"is_wildcard"`
+	Permissions types.Set  `tfsdk:"permissions"` // []AccelByteIamRolePermissionModel
+	Managers    types.Set  `tfsdk:"managers"`    // []AccelByteIamRoleUserModel
+	Members     types.Set  `tfsdk:"members"`     // []AccelByteIamRoleUserModel
+}
+
+var AccelByteIamRolePermissionModelAttributeTypes = map[string]attr.Type{
+	"resource":     types.StringType,
+	"action":       types.Int32Type,
+	"sched_action": types.Int32Type,
+	"sched_cron":   types.StringType,
+	"sched_range":  types.ListType{ElemType: types.StringType},
+}
+
+type AccelByteIamRolePermissionModel struct {
+	Resource    types.String `tfsdk:"resource"`
+	Action      types.Int32  `tfsdk:"action"`
+	SchedAction types.Int32  `tfsdk:"sched_action"`
+	SchedCron   types.String `tfsdk:"sched_cron"`
+	SchedRange  types.List   `tfsdk:"sched_range"` // []string
+}
+
+var AccelByteIamRoleUserModelAttributeTypes = map[string]attr.Type{
+	"namespace": types.StringType,
+	"user_id":   types.StringType,
+}
+
+type AccelByteIamRoleUserModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	UserId    types.String `tfsdk:"user_id"`
+}
+
+// Used by Create, Read and Update operations on IAM roles.
+// This copies data from the AccelByte API `role` to the TF state `data`.
+func updateFromApiIamRole(ctx context.Context, data *AccelByteIamRoleModel, role *iamclientmodels.ModelRoleResponseV3) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.RoleId = types.StringValue(*role.RoleID)
+	data.Id = types.StringValue(*role.RoleID)
+
+	data.Name = types.StringValue(*role.RoleName)
+	data.AdminRole = types.BoolValue(role.AdminRole != nil && *role.AdminRole)
+	data.IsWildcard = types.BoolValue(role.IsWildcard != nil && *role.IsWildcard)
+
+	// Schedule fields that are not in use are omitted by the API; these are reflected as nulls in the state
+	permissions := []AccelByteIamRolePermissionModel{}
+	for _, permission := range role.Permissions {
+		permissionModel := AccelByteIamRolePermissionModel{
+			Resource:    types.StringValue(*permission.Resource),
+			Action:      types.Int32Value(*permission.Action),
+			SchedAction: types.Int32Null(),
+			SchedCron:   types.StringNull(),
+			SchedRange:  types.ListNull(types.StringType),
+		}
+		if permission.SchedAction != 0 {
+			permissionModel.SchedAction = types.Int32Value(permission.SchedAction)
+		}
+		if permission.SchedCron != "" {
+			permissionModel.SchedCron = types.StringValue(permission.SchedCron)
+		}
+		if len(permission.SchedRange) > 0 {
+			schedRange, schedRangeDiags := types.ListValueFrom(ctx, types.StringType, permission.SchedRange)
+			permissionModel.SchedRange = schedRange
+			diags.Append(schedRangeDiags...)
+		}
+		permissions = append(permissions, permissionModel)
+	}
+	permissionsSet, permissionsDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteIamRolePermissionModelAttributeTypes}, permissions)
+	data.Permissions = permissionsSet
+	diags.Append(permissionsDiags...)
+
+	return diags
+}
+
+// Used by Create, Read and Update operations on IAM roles.
+// This copies the AccelByte API users `users` (either managers or members) to a TF set.
+func fromApiIamRoleUsers(ctx context.Context, users []*iamclientmodels.AccountcommonRoleMemberV3) (types.Set, diag.Diagnostics) {
+
+	userModels := []AccelByteIamRoleUserModel{}
+	for _, user := range users {
+		userModels = append(userModels, AccelByteIamRoleUserModel{
+			Namespace: types.StringValue(*user.Namespace),
+			UserId:    types.StringValue(*user.UserID),
+		})
+	}
+
+	return types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteIamRoleUserModelAttributeTypes}, userModels)
+}
+
+// Used by Create/Update operations on IAM roles.
+// This reads from the TF state `data` and returns a list of AccelByte API permissions.
+func toApiIamRolePermissions(ctx context.Context, data AccelByteIamRoleModel) ([]*iamclientmodels.AccountcommonPermissionV3, diag.Diagnostics) {
+
+	permissions := []AccelByteIamRolePermissionModel{}
+	diags := data.Permissions.ElementsAs(ctx, &permissions, false)
+
+	apiPermissions := []*iamclientmodels.AccountcommonPermissionV3{}
+	for _, permission := range permissions {
+		schedRange := []string{}
+		if !permission.SchedRange.IsNull() && !permission.SchedRange.IsUnknown() {
+			diags.Append(permission.SchedRange.ElementsAs(ctx, &schedRange, false)...)
+		}
+
+		apiPermissions = append(apiPermissions, &iamclientmodels.AccountcommonPermissionV3{
+			Resource:    permission.Resource.ValueStringPointer(),
+			Action:      permission.Action.ValueInt32Pointer(),
+			SchedAction: permission.SchedAction.ValueInt32(),
+			SchedCron:   permission.SchedCron.ValueString(),
+			SchedRange:  schedRange,
+		})
+	}
+
+	return apiPermissions, diags
+}
+
+// Used by Create/Update operations on IAM roles.
+// This reads a TF set of users (either managers or members) and returns a list of AccelByte API users.
+// The API requires a display name for each user, but does not use it for anything; it is left empty.
+func toApiIamRoleUsers(ctx context.Context, users types.Set) ([]*iamclientmodels.AccountcommonRoleMemberV3, diag.Diagnostics) {
+
+	userModels := []AccelByteIamRoleUserModel{}
+	diags := users.ElementsAs(ctx, &userModels, false)
+
+	apiUsers := []*iamclientmodels.AccountcommonRoleMemberV3{}
+	for _, user := range userModels {
+		apiUsers = append(apiUsers, &iamclientmodels.AccountcommonRoleMemberV3{
+			DisplayName: new(string),
+			Namespace:   user.Namespace.ValueStringPointer(),
+			UserID:      user.UserId.ValueStringPointer(),
+		})
+	}
+
+	return apiUsers, diags
+}
+
+// Used by the Update operation on IAM roles.
+// Returns the users that are present in `desired` but not in `current`.
+// Users are identified by their namespace and user ID.
+func iamRoleUsersDifference(desired []*iamclientmodels.AccountcommonRoleMemberV3, current []*iamclientmodels.AccountcommonRoleMemberV3) []*iamclientmodels.AccountcommonRoleMemberV3 {
+
+	currentKeys := map[string]bool{}
+	for _, user := range current {
+		currentKeys[*user.Namespace+"/"+*user.UserID] = true
+	}
+
+	difference := []*iamclientmodels.AccountcommonRoleMemberV3{}
+	for _, user := range desired {
+		if !currentKeys[*user.Namespace+"/"+*user.UserID] {
+			difference = append(difference, user)
+		}
+	}
+
+	return difference
+}
+
+// The API uses separate types for managers and members, but they are structurally identical.
+func toApiIamRoleManagers(users []*iamclientmodels.AccountcommonRoleMemberV3) []*iamclientmodels.AccountcommonRoleManagerV3 {
+	managers := []*iamclientmodels.AccountcommonRoleManagerV3{}
+	for _, user := range users {
+		managers = append(managers, (*iamclientmodels.AccountcommonRoleManagerV3)(user))
+	}
+	return managers
+}
+
+func fromApiIamRoleManagers(managers []*iamclientmodels.AccountcommonRoleManagerV3) []*iamclientmodels.AccountcommonRoleMemberV3 {
+	users := []*iamclientmodels.AccountcommonRoleMemberV3{}
+	for _, manager := range managers {
+		users = append(users, (*iamclientmodels.AccountcommonRoleMemberV3)(manager))
+	}
+	return users
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+)
+
+func testIamRoleMember(namespace string, userId string, displayName string) *iamclientmodels.AccountcommonRoleMemberV3 {
+	return &iamclientmodels.AccountcommonRoleMemberV3{
+		Namespace:   &namespace,
+		UserID:      &userId,
+		DisplayName: &displayName,
+	}
+}
+
+func TestIamRoleUsersDifference(t *testing.T) {
+	alice := testIamRoleMember("mygame", "alice", "Alice")
+	bob := testIamRoleMember("mygame", "bob", "Bob")
+	bobElsewhere := testIamRoleMember("othergame", "bob", "Bob")
+	bobRenamed := testIamRoleMember("mygame", "bob", "Robert")
+
+	tests := []struct {
+		name    string
+		desired []*iamclientmodels.AccountcommonRoleMemberV3
+		current []*iamclientmodels.AccountcommonRoleMemberV3
+		want    []string // namespace/userId
+	}{
+		{name: "both empty", desired: nil, current: nil, want: []string{}},
+		{name: "nothing current", desired: []*iamclientmodels.AccountcommonRoleMemberV3{alice, bob}, current: nil, want: []string{"mygame/alice", "mygame/bob"}},
+		{name: "nothing desired", desired: nil, current: []*iamclientmodels.AccountcommonRoleMemberV3{alice}, want: []string{}},
+		{name: "partial overlap", desired: []*iamclientmodels.AccountcommonRoleMemberV3{alice, bob}, current: []*iamclientmodels.AccountcommonRoleMemberV3{alice}, want: []string{"mygame/bob"}},
+		{name: "same user in another namespace", desired: []*iamclientmodels.AccountcommonRoleMemberV3{bobElsewhere}, current: []*iamclientmodels.AccountcommonRoleMemberV3{bob}, want: []string{"othergame/bob"}},
+		{name: "display name is ignored", desired: []*iamclientmodels.AccountcommonRoleMemberV3{bobRenamed}, current: []*iamclientmodels.AccountcommonRoleMemberV3{bob}, want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := []string{}
+			for _, user := range iamRoleUsersDifference(test.desired, test.current) {
+				got = append(got, *user.Namespace+"/"+*user.UserID)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,614 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclient/roles"
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteIamRoleResource{}
+var _ resource.ResourceWithImportState = &AccelByteIamRoleResource{}
+
+const (
+	// Number of role managers / members to fetch per request to the IAM API.
+	IAM_ROLE_USERS_PAGE_SIZE = 100
+)
+
+func NewAccelByteIamRoleResource() resource.Resource {
+	return &AccelByteIamRoleResource{}
+}
+
+// AccelByteIamRoleResource defines the resource implementation.
+type AccelByteIamRoleResource struct {
+	client *iam.RolesService
+}
+
+func (r *AccelByteIamRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_iam_role"
+}
+
+// Schema for the `managers` and `members` nested attributes.
+func iamRoleUsersAttribute(markdownDescription string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: markdownDescription,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"namespace": schema.StringAttribute{
+					MarkdownDescription: "Namespace of the user.",
+					Required:            true,
+				},
+				"user_id": schema.StringAttribute{
+					MarkdownDescription: "ID of the user.",
+					Required:            true,
+				},
+			},
+		},
+		Optional: true,
+		Computed: true,
+		Default:  setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: AccelByteIamRoleUserModelAttributeTypes}, []attr.Value{})),
+	}
+}
+
+func (r *AccelByteIamRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an [IAM role](https://docs.accelbyte.io/gaming-services/services/access/authorization/manage-access-control-for-applications/). Roles are not tied to a namespace; permissions within a role refer to namespaces via their resource strings.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "IAM role identifier. Same as `role_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "Role ID, as assigned by IAM when the role was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of role.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"admin_role": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, this is an admin role. Admin roles can have managers, who can assign the role to other users.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_wildcard": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, the role applies to all namespaces.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "Permissions granted by the role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource": schema.StringAttribute{
+							MarkdownDescription: "Resource that the permission applies to, for example `ADMIN:NAMESPACE:{namespace}:SESSION:CONFIGURATION`.",
+							Required:            true,
+						},
+						"action": schema.Int32Attribute{
+							MarkdownDescription: "Bitmask of actions that are permitted on the resource. 1 = Create, 2 = Read, 4 = Update, 8 = Delete.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.Between(0, 15),
+							},
+						},
+						"sched_action": schema.Int32Attribute{
+							MarkdownDescription: "Bitmask of actions that are permitted on the resource during the scheduled time, if any. Uses the same values as `action`.",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 15),
+							},
+						},
+						"sched_cron": schema.StringAttribute{
+							MarkdownDescription: "Cron expression describing when `sched_action` is permitted.",
+							Optional:            true,
+						},
+						"sched_range": schema.ListAttribute{
+							MarkdownDescription: "Time ranges during which `sched_action` is permitted.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default:  setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: AccelByteIamRolePermissionModelAttributeTypes}, []attr.Value{})),
+			},
+			"managers": iamRoleUsersAttribute("Users who can assign this role to other users. Only applicable to admin roles. Any managers that are not listed here will be removed from the role."),
+			"members":  iamRoleUsersAttribute("Users who have this role. Any members that are not listed here will be removed from the role."),
+		},
+	}
+}
+
+func (r *AccelByteIamRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.IamRolesService
+}
+
+func (r *AccelByteIamRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteIamRoleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPermissions, apiPermissionsDiags := toApiIamRolePermissions(ctx, data)
+	resp.Diagnostics.Append(apiPermissionsDiags...)
+	apiManagers, apiManagersDiags := toApiIamRoleUsers(ctx, data.Managers)
+	resp.Diagnostics.Append(apiManagersDiags...)
+	apiMembers, apiMembersDiags := toApiIamRoleUsers(ctx, data.Members)
+	resp.Diagnostics.Append(apiMembersDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating IAM role via AccelByte API", map[string]interface{}{
+		"name": data.Name,
+	})
+
+	createInput := &roles.AdminCreateRoleV3Params{
+		Body: &iamclientmodels.ModelRoleCreateV3Request{
+			RoleName:    data.Name.ValueStringPointer(),
+			AdminRole:   data.AdminRole.ValueBoolPointer(),
+			IsWildcard:  data.IsWildcard.ValueBoolPointer(),
+			Permissions: apiPermissions,
+			Managers:    toApiIamRoleManagers(apiManagers),
+			Members:     apiMembers,
+			// Roles created by Terraform must also be deletable by Terraform
+			Deletable: true,
+		},
+	}
+
+	createdRole, err := r.client.AdminCreateRoleV3Short(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating IAM role via AccelByte API", fmt.Sprintf("Unable to create IAM role '%s', got error: %s", *createInput.Body.RoleName, err))
+		return
+	}
+
+	data.RoleId = types.StringValue(*createdRole.RoleID)
+
+	// Fetch role immediately after creating it, so we can get the values for un-set defaults
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteIamRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteIamRoleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &roles.AdminGetRoleV3Params{
+		RoleID: data.RoleId.ValueString(),
+	}
+
+	role, err := r.client.AdminGetRoleV3Short(input)
+	if err != nil {
+		notFoundError := &roles.AdminGetRoleV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading IAM role via AccelByte API", fmt.Sprintf("Unable to read IAM role '%s', got error: %s", input.RoleID, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read IAM role from AccelByte API", map[string]interface{}{
+		"roleId": data.RoleId,
+		"role":   role,
+	})
+
+	resp.Diagnostics.Append(updateFromApiIamRole(ctx, &data, role)...)
+	resp.Diagnostics.Append(r.readUsers(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteIamRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteIamRoleModel
+	var state AccelByteIamRoleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleId := data.RoleId.ValueString()
+
+	tflog.Trace(ctx, "Updating IAM role via AccelByte API", map[string]interface{}{
+		"roleId": roleId,
+		"name":   data.Name,
+	})
+
+	input := &roles.AdminUpdateRoleV3Params{
+		RoleID: roleId,
+		Body: &iamclientmodels.ModelRoleUpdateRequestV3{
+			RoleName:   data.Name.ValueStringPointer(),
+			IsWildcard: data.IsWildcard.ValueBoolPointer(),
+			Deletable:  true,
+		},
+	}
+
+	_, err := r.client.AdminUpdateRoleV3Short(input)
+	if err != nil {
+		notFoundError := &roles.AdminUpdateRoleV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("IAM role '%s' does not exist", input.RoleID))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating IAM role via AccelByte API", fmt.Sprintf("Unable to update IAM role '%s', got error: %s", input.RoleID, err))
+			return
+		}
+	}
+
+	// The admin role flag is changed via separate API calls
+
+	if !data.AdminRole.Equal(state.AdminRole) {
+		if data.AdminRole.ValueBool() {
+			err = r.client.AdminUpdateAdminRoleStatusV3Short(&roles.AdminUpdateAdminRoleStatusV3Params{RoleID: roleId})
+		} else {
+			err = r.client.AdminRemoveRoleAdminV3Short(&roles.AdminRemoveRoleAdminV3Params{RoleID: roleId})
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error when updating IAM role admin status via AccelByte API", fmt.Sprintf("Unable to update admin status of IAM role '%s', got error: %s", roleId, err))
+			return
+		}
+	}
+
+	// Replace the complete permission list
+
+	apiPermissions, apiPermissionsDiags := toApiIamRolePermissions(ctx, data)
+	resp.Diagnostics.Append(apiPermissionsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.client.AdminUpdateRolePermissionsV3Short(&roles.AdminUpdateRolePermissionsV3Params{
+		RoleID: roleId,
+		Body: &iamclientmodels.AccountcommonPermissionsV3{
+			Permissions: apiPermissions,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error when updating IAM role permissions via AccelByte API", fmt.Sprintf("Unable to update permissions of IAM role '%s', got error: %s", roleId, err))
+		return
+	}
+
+	// Managers and members can only be added and removed; apply the difference between the backend and the plan
+
+	resp.Diagnostics.Append(r.updateManagers(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(r.updateMembers(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch role after updating it, so we can get the values for un-set defaults
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteIamRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteIamRoleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting IAM role via AccelByte API", map[string]interface{}{
+		"roleId": data.RoleId,
+	})
+
+	input := &roles.AdminDeleteRoleV3Params{
+		RoleID: data.RoleId.ValueString(),
+	}
+	err := r.client.AdminDeleteRoleV3Short(input)
+	if err != nil {
+		notFoundError := &roles.AdminDeleteRoleV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting IAM role via AccelByte API", fmt.Sprintf("Unable to delete IAM role '%s', got error: %s", input.RoleID, err))
+		return
+	}
+}
+
+func (r *AccelByteIamRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "role_id")
+}
+
+// Fetch the role, its managers and its members after a write operation
+func (r *AccelByteIamRoleResource) read(ctx context.Context, data *AccelByteIamRoleModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	input := &roles.AdminGetRoleV3Params{
+		RoleID: data.RoleId.ValueString(),
+	}
+
+	role, err := r.client.AdminGetRoleV3Short(input)
+	if err != nil {
+		diags.AddError("Error when reading IAM role via AccelByte API", fmt.Sprintf("Unable to read IAM role '%s', got error: %s", input.RoleID, err))
+		return diags
+	}
+
+	diags.Append(updateFromApiIamRole(ctx, data, role)...)
+	diags.Append(r.readUsers(ctx, data)...)
+
+	return diags
+}
+
+// Update the TF state `data` with the managers and members of the role
+func (r *AccelByteIamRoleResource) readUsers(ctx context.Context, data *AccelByteIamRoleModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	managers, err := r.listManagers(data.RoleId.ValueString())
+	if err != nil {
+		diags.AddError("Error when reading IAM role managers via AccelByte API", fmt.Sprintf("Unable to read managers of IAM role '%s', got error: %s", data.RoleId.ValueString(), err))
+		return diags
+	}
+	managersSet, managersDiags := fromApiIamRoleUsers(ctx, managers)
+	data.Managers = managersSet
+	diags.Append(managersDiags...)
+
+	members, err := r.listMembers(data.RoleId.ValueString())
+	if err != nil {
+		diags.AddError("Error when reading IAM role members via AccelByte API", fmt.Sprintf("Unable to read members of IAM role '%s', got error: %s", data.RoleId.ValueString(), err))
+		return diags
+	}
+	membersSet, membersDiags := fromApiIamRoleUsers(ctx, members)
+	data.Members = membersSet
+	diags.Append(membersDiags...)
+
+	return diags
+}
+
+// Fetch all managers of the role
+func (r *AccelByteIamRoleResource) listManagers(roleId string) ([]*iamclientmodels.AccountcommonRoleMemberV3, error) {
+
+	managers := []*iamclientmodels.AccountcommonRoleMemberV3{}
+
+	limit := int64(IAM_ROLE_USERS_PAGE_SIZE)
+	var after *string = nil
+
+	for {
+		page, err := r.client.AdminGetRoleManagersV3Short(&roles.AdminGetRoleManagersV3Params{
+			RoleID: roleId,
+			Limit:  &limit,
+			After:  after,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		managers = append(managers, fromApiIamRoleManagers(page.Data)...)
+
+		after = iamRoleUsersNextCursor(page.Paging)
+		if len(page.Data) == 0 || after == nil {
+			return managers, nil
+		}
+	}
+}
+
+// Fetch all members of the role
+func (r *AccelByteIamRoleResource) listMembers(roleId string) ([]*iamclientmodels.AccountcommonRoleMemberV3, error) {
+
+	members := []*iamclientmodels.AccountcommonRoleMemberV3{}
+
+	limit := int64(IAM_ROLE_USERS_PAGE_SIZE)
+	var after *string = nil
+
+	for {
+		page, err := r.client.AdminGetRoleMembersV3Short(&roles.AdminGetRoleMembersV3Params{
+			RoleID: roleId,
+			Limit:  &limit,
+			After:  after,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, page.Data...)
+
+		after = iamRoleUsersNextCursor(page.Paging)
+		if len(page.Data) == 0 || after == nil {
+			return members, nil
+		}
+	}
+}
+
+// The IAM API returns the next page as a URL; extract the `after` cursor from it.
+// Returns nil if there are no more pages.
+func iamRoleUsersNextCursor(paging *iamclientmodels.AccountcommonPaginationV3) *string {
+	if paging == nil || paging.Next == nil || *paging.Next == "" {
+		return nil
+	}
+	next, err := url.Parse(*paging.Next)
+	if err != nil {
+		return nil
+	}
+	after := next.Query().Get("after")
+	if after == "" {
+		return nil
+	}
+	return &after
+}
+
+// Add and remove managers of the role, so that they match the TF plan `data`
+func (r *AccelByteIamRoleResource) updateManagers(ctx context.Context, data AccelByteIamRoleModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	roleId := data.RoleId.ValueString()
+
+	desired, desiredDiags := toApiIamRoleUsers(ctx, data.Managers)
+	diags.Append(desiredDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.listManagers(roleId)
+	if err != nil {
+		diags.AddError("Error when reading IAM role managers via AccelByte API", fmt.Sprintf("Unable to read managers of IAM role '%s', got error: %s", roleId, err))
+		return diags
+	}
+
+	if removed := iamRoleUsersDifference(current, desired); len(removed) > 0 {
+		err = r.client.AdminRemoveRoleManagersV3Short(&roles.AdminRemoveRoleManagersV3Params{
+			RoleID: roleId,
+			Body:   &iamclientmodels.ModelRoleManagersRequestV3{Managers: toApiIamRoleManagers(removed)},
+		})
+		if err != nil {
+			diags.AddError("Error when removing IAM role managers via AccelByte API", fmt.Sprintf("Unable to remove managers from IAM role '%s', got error: %s", roleId, err))
+			return diags
+		}
+	}
+
+	if added := iamRoleUsersDifference(desired, current); len(added) > 0 {
+		err = r.client.AdminAddRoleManagersV3Short(&roles.AdminAddRoleManagersV3Params{
+			RoleID: roleId,
+			Body:   &iamclientmodels.ModelRoleManagersRequestV3{Managers: toApiIamRoleManagers(added)},
+		})
+		if err != nil {
+			diags.AddError("Error when adding IAM role managers via AccelByte API", fmt.Sprintf("Unable to add managers to IAM role '%s', got error: %s", roleId, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Add and remove members of the role, so that they match the TF plan `data`
+func (r *AccelByteIamRoleResource) updateMembers(ctx context.Context, data AccelByteIamRoleModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	roleId := data.RoleId.ValueString()
+
+	desired, desiredDiags := toApiIamRoleUsers(ctx, data.Members)
+	diags.Append(desiredDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := r.listMembers(roleId)
+	if err != nil {
+		diags.AddError("Error when reading IAM role members via AccelByte API", fmt.Sprintf("Unable to read members of IAM role '%s', got error: %s", roleId, err))
+		return diags
+	}
+
+	if removed := iamRoleUsersDifference(current, desired); len(removed) > 0 {
+		err = r.client.AdminRemoveRoleMembersV3Short(&roles.AdminRemoveRoleMembersV3Params{
+			RoleID: roleId,
+			Body:   &iamclientmodels.ModelRoleMembersRequestV3{Members: removed},
+		})
+		if err != nil {
+			diags.AddError("Error when removing IAM role members via AccelByte API", fmt.Sprintf("Unable to remove members from IAM role '%s', got error: %s", roleId, err))
+			return diags
+		}
+	}
+
+	if added := iamRoleUsersDifference(desired, current); len(added) > 0 {
+		err = r.client.AdminAddRoleMembersV3Short(&roles.AdminAddRoleMembersV3Params{
+			RoleID: roleId,
+			Body:   &iamclientmodels.ModelRoleMembersRequestV3{Members: added},
+		})
+		if err != nil {
+			diags.AddError("Error when adding IAM role members via AccelByte API", fmt.Sprintf("Unable to add members to IAM role '%s', got error: %s", roleId, err))
+			return diags
+		}
+	}
+
+	return diags
+}
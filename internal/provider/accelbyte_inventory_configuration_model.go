@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/inventory-sdk/pkg/inventoryclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteInventoryConfigurationModel is used by AccelByteInventoryConfigurationResource.
+type AccelByteInventoryConfigurationModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	InventoryConfigurationId types.String `tfsdk:"inventory_configuration_id"`
+	Id                       types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Code                types.String `tfsdk:"code"`
+	Name                types.String `tfsdk:"name"`
+	InitialMaxSlots     types.Int32  `tfsdk:"initial_max_slots"`
+	MaxSlots            types.Int32  `tfsdk:"max_slots"`
+	MaxInstancesPerUser types.Int32  `tfsdk:"max_instances_per_user"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Description types.String `tfsdk:"description"`
+	AllowShrink types.Bool   `tfsdk:"allow_shrink"`
+}
+
+// Used by Create, Read and Update operations on inventory configurations.
+// This copies data from the AccelByte API `inventoryConfiguration` to the TF state `data`.
+func updateFromApiInventoryConfiguration(data *AccelByteInventoryConfigurationModel, inventoryConfiguration *inventoryclientmodels.ApimodelsInventoryConfigurationResp) {
+	data.Namespace = types.StringValue(*inventoryConfiguration.Namespace)
+	data.InventoryConfigurationId = types.StringValue(*inventoryConfiguration.ID)
+	data.Id = types.StringValue(computeInventoryConfigurationId(*inventoryConfiguration.Namespace, *inventoryConfiguration.ID))
+
+	data.Code = types.StringPointerValue(inventoryConfiguration.Code)
+	data.Name = types.StringPointerValue(inventoryConfiguration.Name)
+	data.InitialMaxSlots = types.Int32PointerValue(inventoryConfiguration.InitialMaxSlots)
+	data.MaxSlots = types.Int32PointerValue(inventoryConfiguration.MaxUpgradeSlots)
+	data.MaxInstancesPerUser = types.Int32PointerValue(inventoryConfiguration.MaxInstancesPerUser)
+
+	data.Description = types.StringPointerValue(inventoryConfiguration.Description)
+
+	// allow_shrink only exists in the TF state; it is not stored in the AccelByte backend
+	if data.AllowShrink.IsNull() || data.AllowShrink.IsUnknown() {
+		data.AllowShrink = types.BoolValue(false)
+	}
+}
+
+// Used by Create and Update operations on inventory configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// The create and update payloads are identical; the create payload is derived from this.
+func toApiInventoryConfiguration(data AccelByteInventoryConfigurationModel) *inventoryclientmodels.ApimodelsInventoryConfigurationReq {
+	return &inventoryclientmodels.ApimodelsInventoryConfigurationReq{
+		Code:                data.Code.ValueStringPointer(),
+		Name:                data.Name.ValueString(),
+		Description:         data.Description.ValueString(),
+		InitialMaxSlots:     data.InitialMaxSlots.ValueInt32Pointer(),
+		MaxUpgradeSlots:     data.MaxSlots.ValueInt32Pointer(),
+		MaxInstancesPerUser: data.MaxInstancesPerUser.ValueInt32Pointer(),
+	}
+}
+
+// Used by the Create operation on inventory configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiInventoryConfigurationCreate(data AccelByteInventoryConfigurationModel) *inventoryclientmodels.ApimodelsCreateInventoryConfigurationReq {
+
+	inventoryConfiguration := toApiInventoryConfiguration(data)
+
+	return &inventoryclientmodels.ApimodelsCreateInventoryConfigurationReq{
+		Code:                inventoryConfiguration.Code,
+		Name:                inventoryConfiguration.Name,
+		Description:         inventoryConfiguration.Description,
+		InitialMaxSlots:     inventoryConfiguration.InitialMaxSlots,
+		MaxUpgradeSlots:     inventoryConfiguration.MaxUpgradeSlots,
+		MaxInstancesPerUser: inventoryConfiguration.MaxInstancesPerUser,
+	}
+}
+
+func computeInventoryConfigurationId(namespace string, inventoryConfigurationId string) string {
+	return fmt.Sprintf("%s/%s", namespace, inventoryConfigurationId)
+}
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/inventory-sdk/pkg/inventoryclient/admin_inventory_configurations"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/inventory"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteInventoryConfigurationResource{}
+var _ resource.ResourceWithImportState = &AccelByteInventoryConfigurationResource{}
+var _ resource.ResourceWithModifyPlan = &AccelByteInventoryConfigurationResource{}
+
+func NewAccelByteInventoryConfigurationResource() resource.Resource {
+	return &AccelByteInventoryConfigurationResource{}
+}
+
+// AccelByteInventoryConfigurationResource defines the resource implementation.
+type AccelByteInventoryConfigurationResource struct {
+	client *inventory.AdminInventoryConfigurationsService
+}
+
+func (r *AccelByteInventoryConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_configuration"
+}
+
+func (r *AccelByteInventoryConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an inventory configuration. An inventory configuration defines a kind of inventory which players can own, such as a backpack or a stash, and how many slots it has.\n\nDecreasing `max_slots` in place is refused unless `allow_shrink` is set, since players may already hold items in the slots which are removed.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the inventory configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"inventory_configuration_id": schema.StringAttribute{
+				MarkdownDescription: "Inventory configuration ID, as assigned by the Inventory service when the configuration was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Inventory configuration identifier, on the format `{{namespace}}/{{inventory_configuration_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"code": schema.StringAttribute{
+				MarkdownDescription: "Code of the inventory configuration, which game clients and servers use to refer to it. Must be unique within the namespace.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the inventory configuration.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"initial_max_slots": schema.Int32Attribute{
+				MarkdownDescription: "Number of slots which a newly created inventory has.",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"max_slots": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of slots which an inventory can be upgraded to. Decreasing this in place requires `allow_shrink` to be set.",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"max_instances_per_user": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of inventories of this configuration which each player can own.",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the inventory configuration.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"allow_shrink": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, `max_slots` can be decreased in place. Shrinking an inventory configuration can make items in the removed slots inaccessible to players. This setting is only used by Terraform; it is not stored in the AccelByte backend.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *AccelByteInventoryConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Slots can only be removed from an existing inventory configuration
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state AccelByteInventoryConfigurationModel
+	var plan AccelByteInventoryConfigurationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.MaxSlots.IsUnknown() || plan.AllowShrink.IsUnknown() {
+		return
+	}
+
+	// Changing the namespace replaces the inventory configuration; the old one's slots are irrelevant
+	if !plan.Namespace.Equal(state.Namespace) {
+		return
+	}
+
+	if plan.MaxSlots.ValueInt32() < state.MaxSlots.ValueInt32() && !plan.AllowShrink.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_slots"),
+			"Refusing to shrink inventory configuration",
+			fmt.Sprintf("Decreasing `max_slots` from %d to %d can make items in the removed slots inaccessible to players. Set `allow_shrink = true` to allow this change.", state.MaxSlots.ValueInt32(), plan.MaxSlots.ValueInt32()),
+		)
+	}
+}
+
+func (r *AccelByteInventoryConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.InventoryAdminInventoryConfigurationsService
+}
+
+func (r *AccelByteInventoryConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteInventoryConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating inventory configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"code":      data.Code,
+	})
+
+	input := &admin_inventory_configurations.AdminCreateInventoryConfigurationParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiInventoryConfigurationCreate(data),
+	}
+
+	inventoryConfiguration, err := r.client.AdminCreateInventoryConfigurationShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating inventory configuration via AccelByte API", fmt.Sprintf("Unable to create inventory configuration '%s' in namespace '%s', got error: %s", data.Code.ValueString(), input.Namespace, err))
+		return
+	}
+
+	updateFromApiInventoryConfiguration(&data, inventoryConfiguration)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteInventoryConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteInventoryConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &admin_inventory_configurations.AdminGetInventoryConfigurationParams{
+		Namespace:                data.Namespace.ValueString(),
+		InventoryConfigurationID: data.InventoryConfigurationId.ValueString(),
+	}
+	inventoryConfiguration, err := r.client.AdminGetInventoryConfigurationShort(input)
+	if err != nil {
+		notFoundError := &admin_inventory_configurations.AdminGetInventoryConfigurationNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading inventory configuration via AccelByte API", fmt.Sprintf("Unable to read inventory configuration '%s' in namespace '%s', got error: %s", input.InventoryConfigurationID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read inventory configuration from AccelByte API", map[string]interface{}{
+		"namespace":                data.Namespace,
+		"inventoryConfigurationId": data.InventoryConfigurationId,
+		"inventoryConfiguration":   inventoryConfiguration,
+	})
+
+	updateFromApiInventoryConfiguration(&data, inventoryConfiguration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteInventoryConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteInventoryConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating inventory configuration via AccelByte API", map[string]interface{}{
+		"namespace":                data.Namespace,
+		"inventoryConfigurationId": data.InventoryConfigurationId,
+	})
+
+	input := &admin_inventory_configurations.AdminUpdateInventoryConfigurationParams{
+		Namespace:                data.Namespace.ValueString(),
+		InventoryConfigurationID: data.InventoryConfigurationId.ValueString(),
+		Body:                     toApiInventoryConfiguration(data),
+	}
+
+	inventoryConfiguration, err := r.client.AdminUpdateInventoryConfigurationShort(input)
+	if err != nil {
+		notFoundError := &admin_inventory_configurations.AdminUpdateInventoryConfigurationNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Inventory configuration '%s' does not exist in namespace '%s'", input.InventoryConfigurationID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating inventory configuration via AccelByte API", fmt.Sprintf("Unable to update inventory configuration '%s' in namespace '%s', got error: %s", input.InventoryConfigurationID, input.Namespace, err))
+			return
+		}
+	}
+
+	updateFromApiInventoryConfiguration(&data, inventoryConfiguration)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteInventoryConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteInventoryConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting inventory configuration via AccelByte API", map[string]interface{}{
+		"namespace":                data.Namespace,
+		"inventoryConfigurationId": data.InventoryConfigurationId,
+	})
+
+	input := &admin_inventory_configurations.AdminDeleteInventoryConfigurationParams{
+		Namespace:                data.Namespace.ValueString(),
+		InventoryConfigurationID: data.InventoryConfigurationId.ValueString(),
+	}
+	err := r.client.AdminDeleteInventoryConfigurationShort(input)
+	if err != nil {
+		notFoundError := &admin_inventory_configurations.AdminDeleteInventoryConfigurationNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting inventory configuration via AccelByte API", fmt.Sprintf("Unable to delete inventory configuration '%s' in namespace '%s', got error: %s", input.InventoryConfigurationID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteInventoryConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "inventory_configuration_id")
+}
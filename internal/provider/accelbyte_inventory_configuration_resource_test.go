@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestInventoryConfigurationModifyPlan(t *testing.T) {
+	r := NewAccelByteInventoryConfigurationResource().(*AccelByteInventoryConfigurationResource)
+	s := testResourceSchema(t, r)
+
+	values := func(namespace string, maxSlots int64, allowShrink any) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"namespace":    tftypes.NewValue(tftypes.String, namespace),
+			"max_slots":    tftypes.NewValue(tftypes.Number, maxSlots),
+			"allow_shrink": tftypes.NewValue(tftypes.Bool, allowShrink),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		state     map[string]tftypes.Value // nil during creation
+		plan      map[string]tftypes.Value // nil during destruction
+		wantError bool
+	}{
+		{name: "create", state: nil, plan: values("mygame", 10, false)},
+		{name: "destroy", state: values("mygame", 10, false), plan: nil},
+		{name: "unchanged", state: values("mygame", 10, false), plan: values("mygame", 10, false)},
+		{name: "grow", state: values("mygame", 10, false), plan: values("mygame", 20, false)},
+		{name: "shrink", state: values("mygame", 20, false), plan: values("mygame", 10, false), wantError: true},
+		{name: "shrink with allow_shrink", state: values("mygame", 20, false), plan: values("mygame", 10, true)},
+		{name: "shrink with unknown allow_shrink", state: values("mygame", 20, false), plan: values("mygame", 10, tftypes.UnknownValue)},
+		{name: "shrink while replacing", state: values("mygame", 20, false), plan: values("othergame", 10, false)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nullObject := tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)
+
+			req := resource.ModifyPlanRequest{
+				State: tfsdk.State{Schema: s, Raw: nullObject},
+				Plan:  tfsdk.Plan{Schema: s, Raw: nullObject},
+			}
+			if test.state != nil {
+				req.State.Raw = testObjectValue(t, s, test.state)
+			}
+			if test.plan != nil {
+				req.Plan.Raw = testObjectValue(t, s, test.plan)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+
+			r.ModifyPlan(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Errorf("got diagnostics %v, want error: %t", resp.Diagnostics, test.wantError)
+			}
+		})
+	}
+}
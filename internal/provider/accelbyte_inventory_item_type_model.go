@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/inventory-sdk/pkg/inventoryclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteInventoryItemTypeModel is used by AccelByteInventoryItemTypeResource.
+type AccelByteInventoryItemTypeModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	Name      types.String `tfsdk:"name"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+}
+
+// Used by Create and Read operations on item types.
+// This copies data from the AccelByte API `itemType` to the TF state `data`.
+func updateFromApiInventoryItemType(data *AccelByteInventoryItemTypeModel, itemType *inventoryclientmodels.ApimodelsCreateItemTypeResp) {
+	data.Namespace = types.StringValue(*itemType.Namespace)
+	data.Name = types.StringValue(*itemType.Name)
+	data.Id = types.StringValue(computeInventoryItemTypeId(*itemType.Namespace, *itemType.Name))
+}
+
+func computeInventoryItemTypeId(namespace string, name string) string {
+	return fmt.Sprintf("%s/%s", namespace, name)
+}
@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/inventory-sdk/pkg/inventoryclient/admin_item_types"
+	"github.com/AccelByte/accelbyte-go-sdk/inventory-sdk/pkg/inventoryclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/inventory"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteInventoryItemTypeResource{}
+var _ resource.ResourceWithImportState = &AccelByteInventoryItemTypeResource{}
+
+const inventoryItemTypesPageSize = 100
+
+func NewAccelByteInventoryItemTypeResource() resource.Resource {
+	return &AccelByteInventoryItemTypeResource{}
+}
+
+// AccelByteInventoryItemTypeResource defines the resource implementation.
+type AccelByteInventoryItemTypeResource struct {
+	client *inventory.AdminItemTypesService
+}
+
+func (r *AccelByteInventoryItemTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_item_type"
+}
+
+func (r *AccelByteInventoryItemTypeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an inventory item type. Item types classify the items which are stored in player inventories, for example `weapon` or `material`.\n\nItem types cannot be renamed; changing `name` replaces the item type.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the item type. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the item type. Must be unique within the namespace.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Item type identifier, on the format `{{namespace}}/{{name}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteInventoryItemTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.InventoryAdminItemTypesService
+}
+
+func (r *AccelByteInventoryItemTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteInventoryItemTypeModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating item type via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+	})
+
+	input := &admin_item_types.AdminCreateItemTypeParams{
+		Namespace: data.Namespace.ValueString(),
+		Body: &inventoryclientmodels.ApimodelsCreateItemTypeReq{
+			Name: data.Name.ValueStringPointer(),
+		},
+	}
+
+	itemType, err := r.client.AdminCreateItemTypeShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating item type via AccelByte API", fmt.Sprintf("Unable to create item type '%s' in namespace '%s', got error: %s", data.Name.ValueString(), input.Namespace, err))
+		return
+	}
+
+	updateFromApiInventoryItemType(&data, itemType)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteInventoryItemTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteInventoryItemTypeModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Inventory service has no endpoint for fetching a single item type, so look for it among all item types
+	itemType, err := r.findItemType(data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading item type via AccelByte API", fmt.Sprintf("Unable to read item type '%s' in namespace '%s', got error: %s", data.Name.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+	if itemType == nil {
+		// The resource does not exist in the AccelByte backend
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read item type from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+		"itemType":  itemType,
+	})
+
+	updateFromApiInventoryItemType(&data, itemType)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteInventoryItemTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteInventoryItemTypeModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All user-settable attributes force replacement; nothing needs to be done here
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteInventoryItemTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteInventoryItemTypeModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting item type via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+	})
+
+	input := &admin_item_types.AdminDeleteItemTypeParams{
+		Namespace:    data.Namespace.ValueString(),
+		ItemTypeName: data.Name.ValueString(),
+	}
+	err := r.client.AdminDeleteItemTypeShort(input)
+	if err != nil {
+		notFoundError := &admin_item_types.AdminDeleteItemTypeNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting item type via AccelByte API", fmt.Sprintf("Unable to delete item type '%s' in namespace '%s', got error: %s", input.ItemTypeName, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteInventoryItemTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "name")
+}
+
+// Find the item type called `name`, going through all item types one page at a time
+// Returns nil if there is no such item type
+func (r *AccelByteInventoryItemTypeResource) findItemType(namespace string, name string) (*inventoryclientmodels.ApimodelsCreateItemTypeResp, error) {
+
+	limit := int64(inventoryItemTypesPageSize)
+	for offset := int64(0); ; offset += limit {
+		offset := offset
+		itemTypes, err := r.client.AdminListItemTypesShort(&admin_item_types.AdminListItemTypesParams{
+			Namespace: namespace,
+			Limit:     &limit,
+			Offset:    &offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, itemType := range itemTypes.Data {
+			if *itemType.Name == name {
+				return itemType, nil
+			}
+		}
+
+		if len(itemTypes.Data) < int(limit) {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/leaderboard-sdk/pkg/leaderboardclientmodels"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AccelByteLeaderboardModel is used by AccelByteLeaderboardResource.
+type AccelByteLeaderboardModel struct {
+	// Populated by user
+	Namespace       types.String `tfsdk:"namespace"`
+	LeaderboardCode types.String `tfsdk:"leaderboard_code"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Name      types.String `tfsdk:"name"`
+	StatCode  types.String `tfsdk:"stat_code"`
+	StartTime types.String `tfsdk:"start_time"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Descending   types.Bool   `tfsdk:"descending"`
+	IconUrl      types.String `tfsdk:"icon_url"`
+	Daily        types.Object `tfsdk:"daily"`   // AccelByteLeaderboardDailyModel
+	Weekly       types.Object `tfsdk:"weekly"`  // AccelByteLeaderboardWeeklyModel
+	Monthly      types.Object `tfsdk:"monthly"` // AccelByteLeaderboardMonthlyModel
+	SeasonPeriod types.Int32  `tfsdk:"season_period"`
+}
+
+var AccelByteLeaderboardDailyModelAttributeTypes = map[string]attr.Type{
+	"reset_time": types.StringType,
+}
+
+type AccelByteLeaderboardDailyModel struct {
+	ResetTime types.String `tfsdk:"reset_time"`
+}
+
+var AccelByteLeaderboardWeeklyModelAttributeTypes = map[string]attr.Type{
+	"reset_day":  types.Int64Type,
+	"reset_time": types.StringType,
+}
+
+type AccelByteLeaderboardWeeklyModel struct {
+	ResetDay  types.Int64  `tfsdk:"reset_day"`
+	ResetTime types.String `tfsdk:"reset_time"`
+}
+
+var AccelByteLeaderboardMonthlyModelAttributeTypes = map[string]attr.Type{
+	"reset_date": types.Int64Type,
+	"reset_time": types.StringType,
+}
+
+type AccelByteLeaderboardMonthlyModel struct {
+	ResetDate types.Int64  `tfsdk:"reset_date"`
+	ResetTime types.String `tfsdk:"reset_time"`
+}
+
+// Used by Create, Read and Update operations on leaderboards.
+// This copies data from the AccelByte API `leaderboard` to the TF state `data`.
+func updateFromApiLeaderboard(ctx context.Context, data *AccelByteLeaderboardModel, leaderboard *leaderboardclientmodels.ModelsGetLeaderboardConfigResp) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.LeaderboardCode = types.StringValue(*leaderboard.LeaderboardCode)
+	data.Id = types.StringValue(computeLeaderboardId(data.Namespace.ValueString(), *leaderboard.LeaderboardCode))
+
+	data.Name = types.StringValue(*leaderboard.Name)
+	data.StatCode = types.StringValue(*leaderboard.StatCode)
+
+	// The API returns the start time as a string rather than as a timestamp
+	if apiStartTime, err := time.Parse(time.RFC3339, *leaderboard.StartTime); err == nil {
+		apiStartDateTime := strfmt.DateTime(apiStartTime)
+		data.StartTime = fromApiTimestamp(data.StartTime, &apiStartDateTime)
+	} else {
+		data.StartTime = types.StringValue(*leaderboard.StartTime)
+	}
+
+	data.Descending = types.BoolValue(leaderboard.Descending != nil && *leaderboard.Descending)
+	data.IconUrl = types.StringPointerValue(leaderboard.IconURL)
+	if data.IconUrl.IsNull() {
+		data.IconUrl = types.StringValue("")
+	}
+
+	// Periodic leaderboards that are not in use are omitted by the API; these are reflected as nulls in the state
+
+	data.Daily = types.ObjectNull(AccelByteLeaderboardDailyModelAttributeTypes)
+	if leaderboard.Daily != nil {
+		daily, dailyDiags := basetypes.NewObjectValueFrom(ctx, AccelByteLeaderboardDailyModelAttributeTypes, AccelByteLeaderboardDailyModel{
+			ResetTime: types.StringPointerValue(leaderboard.Daily.ResetTime),
+		})
+		data.Daily = daily
+		diags.Append(dailyDiags...)
+	}
+
+	data.Weekly = types.ObjectNull(AccelByteLeaderboardWeeklyModelAttributeTypes)
+	if leaderboard.Weekly != nil {
+		weekly, weeklyDiags := basetypes.NewObjectValueFrom(ctx, AccelByteLeaderboardWeeklyModelAttributeTypes, AccelByteLeaderboardWeeklyModel{
+			ResetDay:  types.Int64PointerValue(leaderboard.Weekly.ResetDay),
+			ResetTime: types.StringPointerValue(leaderboard.Weekly.ResetTime),
+		})
+		data.Weekly = weekly
+		diags.Append(weeklyDiags...)
+	}
+
+	data.Monthly = types.ObjectNull(AccelByteLeaderboardMonthlyModelAttributeTypes)
+	if leaderboard.Monthly != nil {
+		monthly, monthlyDiags := basetypes.NewObjectValueFrom(ctx, AccelByteLeaderboardMonthlyModelAttributeTypes, AccelByteLeaderboardMonthlyModel{
+			ResetDate: types.Int64PointerValue(leaderboard.Monthly.ResetDate),
+			ResetTime: types.StringPointerValue(leaderboard.Monthly.ResetTime),
+		})
+		data.Monthly = monthly
+		diags.Append(monthlyDiags...)
+	}
+
+	data.SeasonPeriod = types.Int32Null()
+	if leaderboard.SeasonPeriod != nil && *leaderboard.SeasonPeriod != 0 {
+		data.SeasonPeriod = types.Int32Value(*leaderboard.SeasonPeriod)
+	}
+
+	return diags
+}
+
+// Used by Create/Update operations on leaderboards.
+// This reads from the TF state `data` and returns the AccelByte API objects for the periodic leaderboards.
+func toApiLeaderboardPeriods(ctx context.Context, data AccelByteLeaderboardModel) (*leaderboardclientmodels.ModelsDailyConfig, *leaderboardclientmodels.ModelsWeeklyConfig, *leaderboardclientmodels.ModelsMonthlyConfig, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	var daily *leaderboardclientmodels.ModelsDailyConfig = nil
+	if !data.Daily.IsNull() && !data.Daily.IsUnknown() {
+		var dailyModel AccelByteLeaderboardDailyModel
+		diags.Append(data.Daily.As(ctx, &dailyModel, basetypes.ObjectAsOptions{})...)
+		daily = &leaderboardclientmodels.ModelsDailyConfig{
+			ResetTime: dailyModel.ResetTime.ValueStringPointer(),
+		}
+	}
+
+	var weekly *leaderboardclientmodels.ModelsWeeklyConfig = nil
+	if !data.Weekly.IsNull() && !data.Weekly.IsUnknown() {
+		var weeklyModel AccelByteLeaderboardWeeklyModel
+		diags.Append(data.Weekly.As(ctx, &weeklyModel, basetypes.ObjectAsOptions{})...)
+		weekly = &leaderboardclientmodels.ModelsWeeklyConfig{
+			ResetDay:  weeklyModel.ResetDay.ValueInt64Pointer(),
+			ResetTime: weeklyModel.ResetTime.ValueStringPointer(),
+		}
+	}
+
+	var monthly *leaderboardclientmodels.ModelsMonthlyConfig = nil
+	if !data.Monthly.IsNull() && !data.Monthly.IsUnknown() {
+		var monthlyModel AccelByteLeaderboardMonthlyModel
+		diags.Append(data.Monthly.As(ctx, &monthlyModel, basetypes.ObjectAsOptions{})...)
+		monthly = &leaderboardclientmodels.ModelsMonthlyConfig{
+			ResetDate: monthlyModel.ResetDate.ValueInt64Pointer(),
+			ResetTime: monthlyModel.ResetTime.ValueStringPointer(),
+		}
+	}
+
+	return daily, weekly, monthly, diags
+}
+
+// Used by the Create operation on leaderboards.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiLeaderboardCreate(ctx context.Context, data AccelByteLeaderboardModel) (*leaderboardclientmodels.ModelsLeaderboardConfigReq, diag.Diagnostics) {
+
+	daily, weekly, monthly, diags := toApiLeaderboardPeriods(ctx, data)
+
+	seasonPeriod := data.SeasonPeriod.ValueInt32()
+
+	return &leaderboardclientmodels.ModelsLeaderboardConfigReq{
+		LeaderboardCode: data.LeaderboardCode.ValueStringPointer(),
+		Name:            data.Name.ValueStringPointer(),
+		StatCode:        data.StatCode.ValueStringPointer(),
+		StartTime:       data.StartTime.ValueStringPointer(),
+		Descending:      data.Descending.ValueBoolPointer(),
+		IconURL:         data.IconUrl.ValueStringPointer(),
+		Daily:           daily,
+		Weekly:          weekly,
+		Monthly:         monthly,
+		SeasonPeriod:    &seasonPeriod,
+	}, diags
+}
+
+// Used by the Update operation on leaderboards.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiLeaderboardUpdate(ctx context.Context, data AccelByteLeaderboardModel) (*leaderboardclientmodels.ModelsUpdateLeaderboardConfigReq, diag.Diagnostics) {
+
+	daily, weekly, monthly, diags := toApiLeaderboardPeriods(ctx, data)
+
+	seasonPeriod := data.SeasonPeriod.ValueInt32()
+
+	return &leaderboardclientmodels.ModelsUpdateLeaderboardConfigReq{
+		Name:         data.Name.ValueStringPointer(),
+		StatCode:     data.StatCode.ValueStringPointer(),
+		StartTime:    data.StartTime.ValueStringPointer(),
+		Descending:   data.Descending.ValueBoolPointer(),
+		IconURL:      data.IconUrl.ValueStringPointer(),
+		Daily:        daily,
+		Weekly:       weekly,
+		Monthly:      monthly,
+		SeasonPeriod: &seasonPeriod,
+	}, diags
+}
+
+func computeLeaderboardId(namespace string, leaderboardCode string) string {
+	return fmt.Sprintf("%s/%s", namespace, leaderboardCode)
+}
@@ -0,0 +1,406 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/leaderboard-sdk/pkg/leaderboardclient/leaderboard_configuration"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/leaderboard"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteLeaderboardResource{}
+var _ resource.ResourceWithImportState = &AccelByteLeaderboardResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteLeaderboardResource{}
+
+func NewAccelByteLeaderboardResource() resource.Resource {
+	return &AccelByteLeaderboardResource{}
+}
+
+// AccelByteLeaderboardResource defines the resource implementation.
+type AccelByteLeaderboardResource struct {
+	client *leaderboard.LeaderboardConfigurationService
+}
+
+func (r *AccelByteLeaderboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_leaderboard"
+}
+
+// Schema for the `reset_time` attribute within the periodic leaderboard nested attributes.
+func leaderboardResetTimeAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Time of day at which the leaderboard resets, in 24-hour `HH:MM` format (UTC).",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("00:00"),
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of day in HH:MM format"),
+		},
+	}
+}
+
+func (r *AccelByteLeaderboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [leaderboard](https://docs.accelbyte.io/gaming-services/services/engagement/leaderboards/). A leaderboard ranks players by the value of a statistic. In addition to the all-time leaderboard, daily, weekly, monthly and seasonal leaderboards can be enabled.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the leaderboard. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"leaderboard_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the leaderboard. Lowercase characters, digits, or dashes. Max 48 characters in length. Changing this forces the leaderboard to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`), "must consist of lowercase characters or digits, optionally separated by single dashes"),
+					stringvalidator.LengthAtMost(48),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Leaderboard identifier, on the format `{{namespace}}/{{leaderboard_code}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the leaderboard. Max 128 characters in length.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"stat_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the statistic that the leaderboard ranks players by. See the `accelbyte_statistic` resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"start_time": schema.StringAttribute{
+				MarkdownDescription: "Time at which the leaderboard starts, as an RFC3339 timestamp; for example `2025-01-01T00:00:00Z`.",
+				Required:            true,
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"descending": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, the player with the highest value is ranked first. If set to `false`, the player with the lowest value is ranked first.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"icon_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the leaderboard's icon.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"daily": schema.SingleNestedAttribute{
+				MarkdownDescription: "If set, a daily leaderboard is enabled.",
+				Attributes: map[string]schema.Attribute{
+					"reset_time": leaderboardResetTimeAttribute(),
+				},
+				Optional: true,
+			},
+			"weekly": schema.SingleNestedAttribute{
+				MarkdownDescription: "If set, a weekly leaderboard is enabled.",
+				Attributes: map[string]schema.Attribute{
+					"reset_day": schema.Int64Attribute{
+						MarkdownDescription: "Day of the week on which the leaderboard resets, from 0 (Sunday) to 6 (Saturday).",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 6),
+						},
+					},
+					"reset_time": leaderboardResetTimeAttribute(),
+				},
+				Optional: true,
+			},
+			"monthly": schema.SingleNestedAttribute{
+				MarkdownDescription: "If set, a monthly leaderboard is enabled.",
+				Attributes: map[string]schema.Attribute{
+					"reset_date": schema.Int64Attribute{
+						MarkdownDescription: "Day of the month on which the leaderboard resets, from 1 to 31.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(1),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 31),
+						},
+					},
+					"reset_time": leaderboardResetTimeAttribute(),
+				},
+				Optional: true,
+			},
+			"season_period": schema.Int32Attribute{
+				MarkdownDescription: "If set, a seasonal leaderboard is enabled, with seasons of this many days. Must be greater than 31.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(32),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteLeaderboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteLeaderboardModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.StartTime.IsUnknown() || data.StartTime.IsNull() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, data.StartTime.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("start_time"),
+			"Invalid attribute value",
+			fmt.Sprintf("Attribute `start_time` must be an RFC3339 timestamp, got error: %s", err),
+		)
+	}
+}
+
+func (r *AccelByteLeaderboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.LeaderboardConfigurationService
+}
+
+func (r *AccelByteLeaderboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteLeaderboardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiLeaderboard, apiLeaderboardDiags := toApiLeaderboardCreate(ctx, data)
+	resp.Diagnostics.Append(apiLeaderboardDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating leaderboard via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"leaderboardCode": data.LeaderboardCode,
+	})
+
+	createInput := &leaderboard_configuration.CreateLeaderboardConfigurationAdminV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiLeaderboard,
+	}
+
+	_, err := r.client.CreateLeaderboardConfigurationAdminV1Short(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating leaderboard via AccelByte API", fmt.Sprintf("Unable to create leaderboard '%s' in namespace '%s', got error: %s", data.LeaderboardCode.ValueString(), createInput.Namespace, err))
+		return
+	}
+
+	// Fetch leaderboard immediately after creating it; the create operation does not return the full configuration
+
+	getInput := &leaderboard_configuration.GetLeaderboardConfigurationAdminV1Params{
+		Namespace:       data.Namespace.ValueString(),
+		LeaderboardCode: data.LeaderboardCode.ValueString(),
+	}
+
+	leaderboardConfig, err := r.client.GetLeaderboardConfigurationAdminV1Short(getInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading leaderboard via AccelByte API", fmt.Sprintf("Unable to read leaderboard '%s' in namespace '%s', got error: %s", getInput.LeaderboardCode, getInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiLeaderboard(ctx, &data, leaderboardConfig)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLeaderboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteLeaderboardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &leaderboard_configuration.GetLeaderboardConfigurationAdminV1Params{
+		Namespace:       data.Namespace.ValueString(),
+		LeaderboardCode: data.LeaderboardCode.ValueString(),
+	}
+
+	leaderboardConfig, err := r.client.GetLeaderboardConfigurationAdminV1Short(input)
+	if err != nil {
+		notFoundError := &leaderboard_configuration.GetLeaderboardConfigurationAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading leaderboard via AccelByte API", fmt.Sprintf("Unable to read leaderboard '%s' in namespace '%s', got error: %s", input.LeaderboardCode, input.Namespace, err))
+			return
+		}
+	}
+
+	if leaderboardConfig.IsDeleted != nil && *leaderboardConfig.IsDeleted {
+		// The leaderboard has been deleted, but is still returned by the AccelByte backend
+		// Treat this the same as if the leaderboard did not exist
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read leaderboard from AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"leaderboardCode": data.LeaderboardCode,
+		"leaderboard":     leaderboardConfig,
+	})
+
+	resp.Diagnostics.Append(updateFromApiLeaderboard(ctx, &data, leaderboardConfig)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLeaderboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteLeaderboardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiLeaderboard, apiLeaderboardDiags := toApiLeaderboardUpdate(ctx, data)
+	resp.Diagnostics.Append(apiLeaderboardDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating leaderboard via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"leaderboardCode": data.LeaderboardCode,
+	})
+
+	input := &leaderboard_configuration.UpdateLeaderboardConfigurationAdminV1Params{
+		Namespace:       data.Namespace.ValueString(),
+		LeaderboardCode: data.LeaderboardCode.ValueString(),
+		Body:            apiLeaderboard,
+	}
+
+	leaderboardConfig, err := r.client.UpdateLeaderboardConfigurationAdminV1Short(input)
+	if err != nil {
+		notFoundError := &leaderboard_configuration.UpdateLeaderboardConfigurationAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Leaderboard '%s' does not exist in namespace '%s'", input.LeaderboardCode, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating leaderboard via AccelByte API", fmt.Sprintf("Unable to update leaderboard '%s' in namespace '%s', got error: %s", input.LeaderboardCode, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiLeaderboard(ctx, &data, leaderboardConfig)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLeaderboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteLeaderboardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting leaderboard via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"leaderboardCode": data.LeaderboardCode,
+	})
+
+	input := &leaderboard_configuration.DeleteLeaderboardConfigurationAdminV1Params{
+		Namespace:       data.Namespace.ValueString(),
+		LeaderboardCode: data.LeaderboardCode.ValueString(),
+	}
+	err := r.client.DeleteLeaderboardConfigurationAdminV1Short(input)
+	if err != nil {
+		notFoundError := &leaderboard_configuration.DeleteLeaderboardConfigurationAdminV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting leaderboard via AccelByte API", fmt.Sprintf("Unable to delete leaderboard '%s' in namespace '%s', got error: %s", input.LeaderboardCode, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteLeaderboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "leaderboard_code")
+}
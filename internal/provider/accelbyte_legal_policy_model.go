@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/legal-sdk/pkg/legalclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteLegalPolicyModel is used by AccelByteLegalPolicyResource.
+type AccelByteLegalPolicyModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	BasePolicyId types.String `tfsdk:"base_policy_id"`
+	Id           types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Name              types.String `tfsdk:"name"`
+	PolicyType        types.String `tfsdk:"policy_type"`
+	AffectedCountries types.Set    `tfsdk:"affected_countries"` // []string
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	AffectedClientIds types.Set    `tfsdk:"affected_client_ids"` // []string
+	Tags              types.Set    `tfsdk:"tags"`                // []string
+	Description       types.String `tfsdk:"description"`
+	IsHidden          types.Bool   `tfsdk:"is_hidden"`
+	IsHiddenPublic    types.Bool   `tfsdk:"is_hidden_public"`
+
+	// Computed during Read() operation
+	PolicyIds types.Map `tfsdk:"policy_ids"` // map[string]string
+}
+
+// Used by Create, Read and Update operations on legal policies.
+// This copies data from the AccelByte API `basePolicy` to the TF state `data`.
+// The affected countries are derived from the country-specific policies that the Legal service maintains under the base policy.
+func updateFromApiLegalPolicy(ctx context.Context, data *AccelByteLegalPolicyModel, basePolicy *legalclientmodels.RetrieveBasePolicyResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*basePolicy.Namespace)
+	data.BasePolicyId = types.StringValue(*basePolicy.ID)
+	data.Id = types.StringValue(computeLegalPolicyId(*basePolicy.Namespace, *basePolicy.ID))
+
+	data.Name = types.StringPointerValue(basePolicy.BasePolicyName)
+	data.PolicyType = types.StringValue(basePolicy.PolicyTypeName)
+
+	affectedCountries := []string{}
+	policyIds := map[string]string{}
+	for _, policy := range basePolicy.Policies {
+		affectedCountries = append(affectedCountries, *policy.CountryCode)
+		policyIds[*policy.CountryCode] = *policy.ID
+	}
+
+	affectedCountriesSet, affectedCountriesDiags := types.SetValueFrom(ctx, types.StringType, affectedCountries)
+	data.AffectedCountries = affectedCountriesSet
+	diags.Append(affectedCountriesDiags...)
+
+	policyIdsMap, policyIdsDiags := types.MapValueFrom(ctx, types.StringType, policyIds)
+	data.PolicyIds = policyIdsMap
+	diags.Append(policyIdsDiags...)
+
+	affectedClientIds, affectedClientIdsDiags := setValueFromEvenIfNil(ctx, types.StringType, basePolicy.AffectedClientIds)
+	data.AffectedClientIds = affectedClientIds
+	diags.Append(affectedClientIdsDiags...)
+
+	tags, tagsDiags := setValueFromEvenIfNil(ctx, types.StringType, basePolicy.Tags)
+	data.Tags = tags
+	diags.Append(tagsDiags...)
+
+	data.Description = types.StringValue(basePolicy.Description)
+	data.IsHidden = types.BoolValue(basePolicy.IsHidden)
+	data.IsHiddenPublic = types.BoolValue(basePolicy.IsHiddenPublic)
+
+	return diags
+}
+
+// Used by the Create operation on legal policies.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// The policy type is referenced by ID in the API, so the caller must resolve `policy_type` to `typeId`.
+func toApiLegalPolicyCreate(ctx context.Context, data AccelByteLegalPolicyModel, typeId string) (*legalclientmodels.CreateBasePolicyRequestV2, diag.Diagnostics) {
+
+	apiPolicy, diags := toApiLegalPolicyUpdate(ctx, data)
+
+	return &legalclientmodels.CreateBasePolicyRequestV2{
+		BasePolicyName:    apiPolicy.BasePolicyName,
+		TypeID:            typeId,
+		AffectedCountries: apiPolicy.AffectedCountries,
+		AffectedClientIds: apiPolicy.AffectedClientIds,
+		CountryType:       apiPolicy.CountryType,
+		Tags:              apiPolicy.Tags,
+		Description:       apiPolicy.Description,
+		IsHidden:          apiPolicy.IsHidden,
+		IsHiddenPublic:    apiPolicy.IsHiddenPublic,
+	}, diags
+}
+
+// Used by Create and Update operations on legal policies.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiLegalPolicyUpdate(ctx context.Context, data AccelByteLegalPolicyModel) (*legalclientmodels.UpdateBasePolicyRequestV2, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	affectedCountries := []string{}
+	diags.Append(data.AffectedCountries.ElementsAs(ctx, &affectedCountries, false)...)
+
+	affectedClientIds := []string{}
+	diags.Append(data.AffectedClientIds.ElementsAs(ctx, &affectedClientIds, false)...)
+
+	tags := []string{}
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	return &legalclientmodels.UpdateBasePolicyRequestV2{
+		BasePolicyName:    data.Name.ValueString(),
+		AffectedCountries: affectedCountries,
+		AffectedClientIds: affectedClientIds,
+		CountryType:       legalclientmodels.UpdateBasePolicyRequestV2CountryTypeCOUNTRY,
+		Tags:              tags,
+		Description:       data.Description.ValueString(),
+		IsHidden:          data.IsHidden.ValueBool(),
+		IsHiddenPublic:    data.IsHiddenPublic.ValueBool(),
+	}, diags
+}
+
+func computeLegalPolicyId(namespace string, basePolicyId string) string {
+	return fmt.Sprintf("%s/%s", namespace, basePolicyId)
+}
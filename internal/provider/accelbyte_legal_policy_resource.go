@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/legal-sdk/pkg/legalclient/base_legal_policies_with_namespace"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/legal"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteLegalPolicyResource{}
+var _ resource.ResourceWithImportState = &AccelByteLegalPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &AccelByteLegalPolicyResource{}
+
+func NewAccelByteLegalPolicyResource() resource.Resource {
+	return &AccelByteLegalPolicyResource{}
+}
+
+// AccelByteLegalPolicyResource defines the resource implementation.
+type AccelByteLegalPolicyResource struct {
+	client *legal.BaseLegalPoliciesWithNamespaceService
+}
+
+// Maximum number of policy types to retrieve when resolving a policy type name; the Legal service has a small, fixed set of types.
+const legalPolicyTypesLimit = 100
+
+func (r *AccelByteLegalPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_legal_policy"
+}
+
+func (r *AccelByteLegalPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [legal policy](https://docs.accelbyte.io/gaming-services/services/access/legal/), such as a terms of service or a privacy policy. The Legal service maintains one country-specific policy for each affected country; their IDs are available in `policy_ids`, and are used when creating versions of the policy with `accelbyte_legal_policy_version`.\n\nA policy can only be deleted while none of its versions are published.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the legal policy. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"base_policy_id": schema.StringAttribute{
+				MarkdownDescription: "Base policy ID, as assigned by the Legal service when the policy was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Legal policy identifier, on the format `{{namespace}}/{{base_policy_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the legal policy.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"policy_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the legal policy. Legal documents are shown to players with a document to read; marketing preferences are opt-in choices. Allowed values: `Legal Document`, `Marketing Preference`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("Legal Document", "Marketing Preference"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"affected_countries": schema.SetAttribute{
+				MarkdownDescription: "Countries in which the policy applies, as ISO 3166-1 alpha-2 country codes. The Legal service maintains one country-specific policy for each of these.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z]{2}$`), "Country codes must be two uppercase letters")),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"affected_client_ids": schema.SetAttribute{
+				MarkdownDescription: "IAM client IDs to which the policy applies. If empty, the policy applies to all clients.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the legal policy.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the legal policy.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"is_hidden": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policy is hidden.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_hidden_public": schema.BoolAttribute{
+				MarkdownDescription: "Whether the policy is hidden from the public, unauthenticated policy listings.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+
+			// Computed during Read() operation
+
+			"policy_ids": schema.MapAttribute{
+				MarkdownDescription: "Country-specific policy IDs, keyed by country code. Use these as `policy_id` in `accelbyte_legal_policy_version`.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccelByteLegalPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.LegalBaseLegalPoliciesWithNamespaceService
+}
+
+func (r *AccelByteLegalPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// There is nothing to plan for policy IDs when the resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state AccelByteLegalPolicyModel
+	var plan AccelByteLegalPolicyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The country-specific policies only change when the affected countries change
+	if plan.AffectedCountries.Equal(state.AffectedCountries) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("policy_ids"), state.PolicyIds)...)
+	}
+}
+
+// Resolves a policy type name, such as "Legal Document", to the ID of that policy type.
+func (r *AccelByteLegalPolicyResource) lookupPolicyTypeId(namespace string, policyTypeName string) (string, error) {
+	policyTypes, err := r.client.RetrieveAllPolicyTypes1Short(&base_legal_policies_with_namespace.RetrieveAllPolicyTypes1Params{
+		Namespace: namespace,
+		Limit:     legalPolicyTypesLimit,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, policyType := range policyTypes {
+		if policyType.PolicyTypeName != nil && *policyType.PolicyTypeName == policyTypeName {
+			return *policyType.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("policy type '%s' does not exist", policyTypeName)
+}
+
+func (r *AccelByteLegalPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteLegalPolicyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typeId, err := r.lookupPolicyTypeId(data.Namespace.ValueString(), data.PolicyType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when looking up legal policy type via AccelByte API", fmt.Sprintf("Unable to look up policy type '%s' in namespace '%s', got error: %s", data.PolicyType.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+
+	apiPolicy, apiPolicyDiags := toApiLegalPolicyCreate(ctx, data, typeId)
+	resp.Diagnostics.Append(apiPolicyDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating legal policy via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+	})
+
+	input := &base_legal_policies_with_namespace.CreatePolicy1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiPolicy,
+	}
+
+	createdPolicy, err := r.client.CreatePolicy1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating legal policy via AccelByte API", fmt.Sprintf("Unable to create legal policy '%s' in namespace '%s', got error: %s", data.Name.ValueString(), input.Namespace, err))
+		return
+	}
+
+	// The create response does not include the country-specific policies, so the policy is read back in full
+	basePolicy, err := r.client.RetrieveSinglePolicy1Short(&base_legal_policies_with_namespace.RetrieveSinglePolicy1Params{
+		Namespace:    input.Namespace,
+		BasePolicyID: *createdPolicy.ID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading legal policy via AccelByte API", fmt.Sprintf("Unable to read legal policy '%s' in namespace '%s', got error: %s", *createdPolicy.ID, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiLegalPolicy(ctx, &data, basePolicy)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLegalPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteLegalPolicyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &base_legal_policies_with_namespace.RetrieveSinglePolicy1Params{
+		Namespace:    data.Namespace.ValueString(),
+		BasePolicyID: data.BasePolicyId.ValueString(),
+	}
+	basePolicy, err := r.client.RetrieveSinglePolicy1Short(input)
+	if err != nil {
+		notFoundError := &base_legal_policies_with_namespace.RetrieveSinglePolicy1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading legal policy via AccelByte API", fmt.Sprintf("Unable to read legal policy '%s' in namespace '%s', got error: %s", input.BasePolicyID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read legal policy from AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"basePolicyId": data.BasePolicyId,
+		"basePolicy":   basePolicy,
+	})
+
+	resp.Diagnostics.Append(updateFromApiLegalPolicy(ctx, &data, basePolicy)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLegalPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteLegalPolicyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiPolicy, apiPolicyDiags := toApiLegalPolicyUpdate(ctx, data)
+	resp.Diagnostics.Append(apiPolicyDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating legal policy via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"basePolicyId": data.BasePolicyId,
+	})
+
+	input := &base_legal_policies_with_namespace.PartialUpdatePolicy1Params{
+		Namespace:    data.Namespace.ValueString(),
+		BasePolicyID: data.BasePolicyId.ValueString(),
+		Body:         apiPolicy,
+	}
+
+	_, err := r.client.PartialUpdatePolicy1Short(input)
+	if err != nil {
+		// Failed to update the resource in the AccelByte backend
+		// The backend refused our update operation; we should abort
+		resp.Diagnostics.AddError("Error when updating legal policy via AccelByte API", fmt.Sprintf("Unable to update legal policy '%s' in namespace '%s', got error: %s", input.BasePolicyID, input.Namespace, err))
+		return
+	}
+
+	// The update response does not include the country-specific policies, so the policy is read back in full
+	basePolicy, err := r.client.RetrieveSinglePolicy1Short(&base_legal_policies_with_namespace.RetrieveSinglePolicy1Params{
+		Namespace:    input.Namespace,
+		BasePolicyID: input.BasePolicyID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading legal policy via AccelByte API", fmt.Sprintf("Unable to read legal policy '%s' in namespace '%s', got error: %s", input.BasePolicyID, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiLegalPolicy(ctx, &data, basePolicy)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLegalPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteLegalPolicyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting legal policy via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"basePolicyId": data.BasePolicyId,
+	})
+
+	input := &base_legal_policies_with_namespace.DeleteBasePolicyParams{
+		Namespace:    data.Namespace.ValueString(),
+		BasePolicyID: data.BasePolicyId.ValueString(),
+	}
+	err := r.client.DeleteBasePolicyShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting legal policy via AccelByte API", fmt.Sprintf("Unable to delete legal policy '%s' in namespace '%s', got error: %s", input.BasePolicyID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteLegalPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "base_policy_id")
+}
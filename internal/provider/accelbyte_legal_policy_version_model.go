@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/legal-sdk/pkg/legalclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteLegalPolicyVersionModel is used by AccelByteLegalPolicyVersionResource.
+type AccelByteLegalPolicyVersionModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	PolicyId  types.String `tfsdk:"policy_id"`
+
+	// Computed during Create() operation
+	PolicyVersionId types.String `tfsdk:"policy_version_id"`
+	Id              types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	DisplayVersion types.String `tfsdk:"display_version"`
+	Localizations  types.List   `tfsdk:"localizations"` // []AccelByteLegalPolicyVersionLocalizationModel
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Description       types.String `tfsdk:"description"`
+	DefaultLocaleCode types.String `tfsdk:"default_locale_code"`
+
+	// Can be set by user; controls publishing of the version
+	Published            types.Bool `tfsdk:"published"`
+	NotifyUsersOnPublish types.Bool `tfsdk:"notify_users_on_publish"`
+}
+
+var AccelByteLegalPolicyVersionLocalizationModelAttributeTypes = map[string]attr.Type{
+	"locale_code":  types.StringType,
+	"content_type": types.StringType,
+	"description":  types.StringType,
+	"content":      types.StringType,
+}
+
+type AccelByteLegalPolicyVersionLocalizationModel struct {
+	LocaleCode  types.String `tfsdk:"locale_code"`
+	ContentType types.String `tfsdk:"content_type"`
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+}
+
+// Used by Create, Read and Update operations on legal policy versions.
+// This copies data from the AccelByte API `policyVersion` to the TF state `data`.
+// Attachment contents are stored by the Legal service as files, and are not read back; the TF state keeps the contents that were last uploaded.
+func updateFromApiLegalPolicyVersion(ctx context.Context, data *AccelByteLegalPolicyVersionModel, policyVersion *legalclientmodels.RetrievePolicyVersionResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.PolicyVersionId = types.StringValue(*policyVersion.ID)
+	data.Id = types.StringValue(computeLegalPolicyVersionId(data.Namespace.ValueString(), data.PolicyId.ValueString(), *policyVersion.ID))
+
+	data.DisplayVersion = types.StringPointerValue(policyVersion.DisplayVersion)
+	data.Description = types.StringValue(policyVersion.Description)
+	data.Published = types.BoolValue(policyVersion.PublishedDate != nil)
+
+	previousLocalizations := []AccelByteLegalPolicyVersionLocalizationModel{}
+	if !data.Localizations.IsNull() && !data.Localizations.IsUnknown() {
+		diags.Append(data.Localizations.ElementsAs(ctx, &previousLocalizations, false)...)
+	}
+
+	apiLocalizations := map[string]*legalclientmodels.LocalizedPolicyVersionObject{}
+	for _, apiLocalization := range policyVersion.LocalizedPolicyVersions {
+		apiLocalizations[*apiLocalization.LocaleCode] = apiLocalization
+	}
+
+	// Keep localizations in the order of the TF state, followed by any localizations that only exist in the backend
+	localizations := []AccelByteLegalPolicyVersionLocalizationModel{}
+	for _, previousLocalization := range previousLocalizations {
+		if apiLocalization, ok := apiLocalizations[previousLocalization.LocaleCode.ValueString()]; ok {
+			localizations = append(localizations, AccelByteLegalPolicyVersionLocalizationModel{
+				LocaleCode:  types.StringPointerValue(apiLocalization.LocaleCode),
+				ContentType: types.StringValue(apiLocalization.ContentType),
+				Description: types.StringValue(apiLocalization.Description),
+				Content:     previousLocalization.Content,
+			})
+			delete(apiLocalizations, previousLocalization.LocaleCode.ValueString())
+		}
+	}
+	for _, apiLocalization := range policyVersion.LocalizedPolicyVersions {
+		if _, ok := apiLocalizations[*apiLocalization.LocaleCode]; ok {
+			localizations = append(localizations, AccelByteLegalPolicyVersionLocalizationModel{
+				LocaleCode:  types.StringPointerValue(apiLocalization.LocaleCode),
+				ContentType: types.StringValue(apiLocalization.ContentType),
+				Description: types.StringValue(apiLocalization.Description),
+				Content:     types.StringNull(),
+			})
+		}
+	}
+
+	localizationsList, localizationsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteLegalPolicyVersionLocalizationModelAttributeTypes}, localizations)
+	data.Localizations = localizationsList
+	diags.Append(localizationsDiags...)
+
+	// An empty string, rather than null, signals that no localization has been selected as the default
+	data.DefaultLocaleCode = types.StringValue("")
+	for _, apiLocalization := range policyVersion.LocalizedPolicyVersions {
+		if apiLocalization.IsDefaultSelection != nil && *apiLocalization.IsDefaultSelection {
+			data.DefaultLocaleCode = types.StringPointerValue(apiLocalization.LocaleCode)
+		}
+	}
+
+	return diags
+}
+
+// Used by the Create operation on legal policy versions.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Localizations are created separately.
+func toApiLegalPolicyVersion(data AccelByteLegalPolicyVersionModel) *legalclientmodels.CreatePolicyVersionRequest {
+	return &legalclientmodels.CreatePolicyVersionRequest{
+		DisplayVersion: data.DisplayVersion.ValueString(),
+		Description:    data.Description.ValueString(),
+		IsCommitted:    false,
+	}
+}
+
+func computeLegalPolicyVersionId(namespace string, policyId string, policyVersionId string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, policyId, policyVersionId)
+}
@@ -0,0 +1,618 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/AccelByte/accelbyte-go-sdk/legal-sdk/pkg/legalclient/localized_policy_versions_with_namespace"
+	"github.com/AccelByte/accelbyte-go-sdk/legal-sdk/pkg/legalclient/policy_versions_with_namespace"
+	"github.com/AccelByte/accelbyte-go-sdk/legal-sdk/pkg/legalclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/legal"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteLegalPolicyVersionResource{}
+var _ resource.ResourceWithImportState = &AccelByteLegalPolicyVersionResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteLegalPolicyVersionResource{}
+
+func NewAccelByteLegalPolicyVersionResource() resource.Resource {
+	return &AccelByteLegalPolicyVersionResource{}
+}
+
+// AccelByteLegalPolicyVersionResource defines the resource implementation.
+type AccelByteLegalPolicyVersionResource struct {
+	client          *legal.PolicyVersionsWithNamespaceService
+	localizedClient *legal.LocalizedPolicyVersionsWithNamespaceService
+}
+
+func (r *AccelByteLegalPolicyVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_legal_policy_version"
+}
+
+func (r *AccelByteLegalPolicyVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a version of a country-specific [legal policy](https://docs.accelbyte.io/gaming-services/services/access/legal/), together with its localized documents. Each localization's content is uploaded to the Legal service as an attachment.\n\n" +
+			"Policy versions are immutable: any change other than `published` and `notify_users_on_publish` replaces the version. Setting `published` to `true` publishes the version, and setting it back to `false` unpublishes it.\n\n" +
+			"The Legal service does not allow deleting or unpublishing a version that has been accepted by any user. Destroying such a version removes it from the Terraform state only, and leaves it in place in the backend.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the legal policy. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the country-specific policy which this is a version of. This is typically taken from the `policy_ids` attribute of an `accelbyte_legal_policy` resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"policy_version_id": schema.StringAttribute{
+				MarkdownDescription: "Policy version ID, as assigned by the Legal service when the version was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Legal policy version identifier, on the format `{{namespace}}/{{policy_id}}/{{policy_version_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"display_version": schema.StringAttribute{
+				MarkdownDescription: "Version number which is shown to players, for example `1.0`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"localizations": schema.ListNestedAttribute{
+				MarkdownDescription: "Localized documents of the policy version. Contents are not read back from the Legal service; changes made outside of Terraform will not be detected.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"locale_code": schema.StringAttribute{
+							MarkdownDescription: "Locale of the document, for example `en` or `en-US`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"content_type": schema.StringAttribute{
+							MarkdownDescription: "MIME type of the document, for example `text/html` or `text/markdown`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the localized document.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"content": schema.StringAttribute{
+							MarkdownDescription: "Contents of the document, for example `file(\"terms-of-service.en.html\")`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the policy version.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_locale_code": schema.StringAttribute{
+				MarkdownDescription: "Locale of the localization which is shown when there is no localization for the player's locale. Must match the `locale_code` of one of the localizations. If not set, the Legal service's choice is kept; an empty string means that no default has been selected.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Can be set by user; controls publishing of the version
+
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "Whether the version is published. Publishing a version makes it the version in effect for its country-specific policy.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"notify_users_on_publish": schema.BoolAttribute{
+				MarkdownDescription: "Whether to notify players who have accepted an earlier version when this version is published.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *AccelByteLegalPolicyVersionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteLegalPolicyVersionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Localizations.IsNull() || data.Localizations.IsUnknown() {
+		return
+	}
+
+	localizations := []AccelByteLegalPolicyVersionLocalizationModel{}
+	resp.Diagnostics.Append(data.Localizations.ElementsAs(ctx, &localizations, false)...)
+
+	localeCodes := map[string]bool{}
+	for index, localization := range localizations {
+		if localization.LocaleCode.IsNull() || localization.LocaleCode.IsUnknown() {
+			// The default locale cannot be checked against an incomplete set of locales
+			return
+		}
+		localeCode := localization.LocaleCode.ValueString()
+		if localeCodes[localeCode] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("localizations").AtListIndex(index).AtName("locale_code"),
+				"Duplicate localization",
+				fmt.Sprintf("Attribute `localizations` contains more than one localization for locale '%s'", localeCode),
+			)
+		}
+		localeCodes[localeCode] = true
+	}
+
+	if !data.DefaultLocaleCode.IsNull() && !data.DefaultLocaleCode.IsUnknown() && !localeCodes[data.DefaultLocaleCode.ValueString()] {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_locale_code"),
+			"Unknown default locale",
+			fmt.Sprintf("Attribute `default_locale_code` is '%s', but there is no localization for that locale", data.DefaultLocaleCode.ValueString()),
+		)
+	}
+}
+
+func (r *AccelByteLegalPolicyVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.LegalPolicyVersionsWithNamespaceService
+	r.localizedClient = clients.LegalLocalizedPolicyVersionsWithNamespaceService
+}
+
+// Retrieves the policy version described by `data`. Returns nil if the policy version does not exist.
+func (r *AccelByteLegalPolicyVersionResource) read(data AccelByteLegalPolicyVersionModel) (*legalclientmodels.RetrievePolicyVersionResponse, error) {
+	policyVersions, err := r.client.RetrieveSinglePolicyVersion1Short(&policy_versions_with_namespace.RetrieveSinglePolicyVersion1Params{
+		Namespace: data.Namespace.ValueString(),
+		PolicyID:  data.PolicyId.ValueString(),
+		VersionID: data.PolicyVersionId.ValueStringPointer(),
+	})
+	if err != nil {
+		notFoundError := &policy_versions_with_namespace.RetrieveSinglePolicyVersion1NotFound{}
+		if errors.As(err, &notFoundError) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, policyVersion := range policyVersions {
+		if policyVersion.ID != nil && *policyVersion.ID == data.PolicyVersionId.ValueString() {
+			return policyVersion, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Creates the localization described by `localization` under the policy version described by `data`, and uploads its content.
+// Returns the ID of the localized policy version.
+func (r *AccelByteLegalPolicyVersionResource) createLocalization(ctx context.Context, data AccelByteLegalPolicyVersionModel, localization AccelByteLegalPolicyVersionLocalizationModel) (string, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	tflog.Trace(ctx, "Creating localized legal policy version via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"policyVersionId": data.PolicyVersionId,
+		"localeCode":      localization.LocaleCode,
+	})
+
+	createInput := &localized_policy_versions_with_namespace.CreateLocalizedPolicyVersion1Params{
+		Namespace:       data.Namespace.ValueString(),
+		PolicyVersionID: data.PolicyVersionId.ValueString(),
+		Body: &legalclientmodels.CreateLocalizedPolicyVersionRequest{
+			LocaleCode:  localization.LocaleCode.ValueString(),
+			ContentType: localization.ContentType.ValueString(),
+			Description: localization.Description.ValueString(),
+		},
+	}
+
+	createdLocalization, err := r.localizedClient.CreateLocalizedPolicyVersion1Short(createInput)
+	if err != nil {
+		diags.AddError("Error when creating localized legal policy version via AccelByte API", fmt.Sprintf("Unable to create localization '%s' of legal policy version '%s' in namespace '%s', got error: %s", createInput.Body.LocaleCode, createInput.PolicyVersionID, createInput.Namespace, err))
+		return "", diags
+	}
+
+	localizedPolicyVersionId := *createdLocalization.ID
+	content := []byte(localization.Content.ValueString())
+	contentMd5 := md5.Sum(content)
+
+	// The content is uploaded directly to storage, via a presigned URL which is issued by the Legal service
+	presignInput := &localized_policy_versions_with_namespace.RequestPresignedURL1Params{
+		Namespace:                data.Namespace.ValueString(),
+		LocalizedPolicyVersionID: localizedPolicyVersionId,
+		Body: &legalclientmodels.UploadPolicyVersionAttachmentRequest{
+			ContentMD5:  base64.StdEncoding.EncodeToString(contentMd5[:]),
+			ContentType: localization.ContentType.ValueString(),
+		},
+	}
+
+	presignedUrl, err := r.localizedClient.RequestPresignedURL1Short(presignInput)
+	if err != nil {
+		diags.AddError("Error when requesting attachment upload URL via AccelByte API", fmt.Sprintf("Unable to request upload URL for localization '%s' of legal policy version '%s' in namespace '%s', got error: %s", createInput.Body.LocaleCode, createInput.PolicyVersionID, presignInput.Namespace, err))
+		return "", diags
+	}
+
+	err = uploadLegalPolicyVersionAttachment(ctx, presignedUrl.AttachmentUploadURL, presignInput.Body.ContentType, presignInput.Body.ContentMD5, content)
+	if err != nil {
+		diags.AddError("Error when uploading legal policy version attachment", fmt.Sprintf("Unable to upload content of localization '%s' of legal policy version '%s' in namespace '%s', got error: %s", createInput.Body.LocaleCode, createInput.PolicyVersionID, presignInput.Namespace, err))
+		return "", diags
+	}
+
+	updateInput := &localized_policy_versions_with_namespace.UpdateLocalizedPolicyVersion1Params{
+		Namespace:                data.Namespace.ValueString(),
+		LocalizedPolicyVersionID: localizedPolicyVersionId,
+		Body: &legalclientmodels.UpdateLocalizedPolicyVersionRequest{
+			AttachmentChecksum: presignedUrl.AttachmentChecksum,
+			AttachmentLocation: presignedUrl.AttachmentLocation,
+			ContentType:        localization.ContentType.ValueString(),
+			Description:        localization.Description.ValueString(),
+		},
+	}
+
+	_, err = r.localizedClient.UpdateLocalizedPolicyVersion1Short(updateInput)
+	if err != nil {
+		diags.AddError("Error when updating localized legal policy version via AccelByte API", fmt.Sprintf("Unable to attach content to localization '%s' of legal policy version '%s' in namespace '%s', got error: %s", createInput.Body.LocaleCode, createInput.PolicyVersionID, updateInput.Namespace, err))
+		return "", diags
+	}
+
+	return localizedPolicyVersionId, diags
+}
+
+// Uploads `content` to a presigned URL that has been issued by the Legal service.
+func uploadLegalPolicyVersionAttachment(ctx context.Context, uploadUrl string, contentType string, contentMd5 string, content []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uploadUrl, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Content-MD5", contentMd5)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("upload failed with status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Publishes or unpublishes the policy version described by `data`, according to `data.Published`.
+func (r *AccelByteLegalPolicyVersionResource) setPublished(ctx context.Context, data AccelByteLegalPolicyVersionModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	if data.Published.ValueBool() {
+		tflog.Trace(ctx, "Publishing legal policy version via AccelByte API", map[string]interface{}{
+			"namespace":       data.Namespace,
+			"policyVersionId": data.PolicyVersionId,
+		})
+
+		input := &policy_versions_with_namespace.PublishPolicyVersion1Params{
+			Namespace:       data.Namespace.ValueString(),
+			PolicyVersionID: data.PolicyVersionId.ValueString(),
+			ShouldNotify:    data.NotifyUsersOnPublish.ValueBoolPointer(),
+		}
+		err := r.client.PublishPolicyVersion1Short(input)
+		if err != nil {
+			diags.AddError("Error when publishing legal policy version via AccelByte API", fmt.Sprintf("Unable to publish legal policy version '%s' in namespace '%s', got error: %s", input.PolicyVersionID, input.Namespace, err))
+		}
+	} else {
+		tflog.Trace(ctx, "Unpublishing legal policy version via AccelByte API", map[string]interface{}{
+			"namespace":       data.Namespace,
+			"policyVersionId": data.PolicyVersionId,
+		})
+
+		input := &policy_versions_with_namespace.UnpublishPolicyVersionParams{
+			Namespace:       data.Namespace.ValueString(),
+			PolicyVersionID: data.PolicyVersionId.ValueString(),
+		}
+		err := r.client.UnpublishPolicyVersionShort(input)
+		if err != nil {
+			diags.AddError("Error when unpublishing legal policy version via AccelByte API", fmt.Sprintf("Unable to unpublish legal policy version '%s' in namespace '%s', got error: %s", input.PolicyVersionID, input.Namespace, err))
+		}
+	}
+
+	return diags
+}
+
+func (r *AccelByteLegalPolicyVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteLegalPolicyVersionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localizations := []AccelByteLegalPolicyVersionLocalizationModel{}
+	resp.Diagnostics.Append(data.Localizations.ElementsAs(ctx, &localizations, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating legal policy version via AccelByte API", map[string]interface{}{
+		"namespace":      data.Namespace,
+		"policyId":       data.PolicyId,
+		"displayVersion": data.DisplayVersion,
+	})
+
+	input := &policy_versions_with_namespace.CreatePolicyVersion1Params{
+		Namespace: data.Namespace.ValueString(),
+		PolicyID:  data.PolicyId.ValueString(),
+		Body:      toApiLegalPolicyVersion(data),
+	}
+
+	createdPolicyVersion, err := r.client.CreatePolicyVersion1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating legal policy version via AccelByte API", fmt.Sprintf("Unable to create version '%s' of legal policy '%s' in namespace '%s', got error: %s", input.Body.DisplayVersion, input.PolicyID, input.Namespace, err))
+		return
+	}
+
+	published := data.Published
+	data.PolicyVersionId = types.StringPointerValue(createdPolicyVersion.ID)
+	data.Id = types.StringValue(computeLegalPolicyVersionId(input.Namespace, input.PolicyID, *createdPolicyVersion.ID))
+	data.Published = types.BoolValue(false)
+	if data.DefaultLocaleCode.IsUnknown() {
+		data.DefaultLocaleCode = types.StringValue("")
+	}
+
+	// Save the policy version into Terraform state before creating localizations, so that the version is tracked even if a later step fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localizedPolicyVersionIds := map[string]string{}
+	for _, localization := range localizations {
+		localizedPolicyVersionId, localizationDiags := r.createLocalization(ctx, data, localization)
+		resp.Diagnostics.Append(localizationDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		localizedPolicyVersionIds[localization.LocaleCode.ValueString()] = localizedPolicyVersionId
+	}
+
+	if data.DefaultLocaleCode.ValueString() != "" {
+		setDefaultInput := &localized_policy_versions_with_namespace.SetDefaultPolicy1Params{
+			Namespace:                data.Namespace.ValueString(),
+			LocalizedPolicyVersionID: localizedPolicyVersionIds[data.DefaultLocaleCode.ValueString()],
+		}
+		err := r.localizedClient.SetDefaultPolicy1Short(setDefaultInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Error when setting default localization via AccelByte API", fmt.Sprintf("Unable to set localization '%s' as default for legal policy version '%s' in namespace '%s', got error: %s", data.DefaultLocaleCode.ValueString(), data.PolicyVersionId.ValueString(), setDefaultInput.Namespace, err))
+			return
+		}
+	}
+
+	if published.ValueBool() {
+		data.Published = published
+		resp.Diagnostics.Append(r.setPublished(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	policyVersion, err := r.read(data)
+	if err != nil || policyVersion == nil {
+		resp.Diagnostics.AddError("Error when reading legal policy version via AccelByte API", fmt.Sprintf("Unable to read legal policy version '%s' in namespace '%s' after creating it, got error: %v", data.PolicyVersionId.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiLegalPolicyVersion(ctx, &data, policyVersion)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLegalPolicyVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteLegalPolicyVersionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyVersion, err := r.read(data)
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading legal policy version via AccelByte API", fmt.Sprintf("Unable to read legal policy version '%s' in namespace '%s', got error: %s", data.PolicyVersionId.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+
+	if policyVersion == nil {
+		// The resource does not exist in the AccelByte backend
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read legal policy version from AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"policyVersionId": data.PolicyVersionId,
+		"policyVersion":   policyVersion,
+	})
+
+	resp.Diagnostics.Append(updateFromApiLegalPolicyVersion(ctx, &data, policyVersion)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLegalPolicyVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteLegalPolicyVersionModel
+	var state AccelByteLegalPolicyVersionModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All other attributes require replacement, so publishing is the only change that can be applied in place
+	if !data.Published.Equal(state.Published) {
+		resp.Diagnostics.Append(r.setPublished(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	policyVersion, err := r.read(data)
+	if err != nil || policyVersion == nil {
+		resp.Diagnostics.AddError("Error when reading legal policy version via AccelByte API", fmt.Sprintf("Unable to read legal policy version '%s' in namespace '%s' after updating it, got error: %v", data.PolicyVersionId.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiLegalPolicyVersion(ctx, &data, policyVersion)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLegalPolicyVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteLegalPolicyVersionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting legal policy version via AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"policyVersionId": data.PolicyVersionId,
+	})
+
+	// Published versions must be unpublished before they can be deleted
+	// The Legal service refuses both operations for versions that have been accepted by users; these are left in place in the backend
+	if data.Published.ValueBool() {
+		unpublishInput := &policy_versions_with_namespace.UnpublishPolicyVersionParams{
+			Namespace:       data.Namespace.ValueString(),
+			PolicyVersionID: data.PolicyVersionId.ValueString(),
+		}
+		err := r.client.UnpublishPolicyVersionShort(unpublishInput)
+		if err != nil {
+			badRequestError := &policy_versions_with_namespace.UnpublishPolicyVersionBadRequest{}
+			if !errors.As(err, &badRequestError) {
+				resp.Diagnostics.AddError("Error when unpublishing legal policy version via AccelByte API", fmt.Sprintf("Unable to unpublish legal policy version '%s' in namespace '%s', got error: %s", unpublishInput.PolicyVersionID, unpublishInput.Namespace, err))
+				return
+			}
+			resp.Diagnostics.AddWarning("Legal policy version left in place", fmt.Sprintf("Unable to unpublish legal policy version '%s' in namespace '%s', likely because it has been accepted by users. It has been removed from the Terraform state, but remains published in the backend. Error: %s", unpublishInput.PolicyVersionID, unpublishInput.Namespace, err))
+			return
+		}
+	}
+
+	input := &policy_versions_with_namespace.DeletePolicyVersionParams{
+		Namespace:       data.Namespace.ValueString(),
+		PolicyVersionID: data.PolicyVersionId.ValueString(),
+	}
+	err := r.client.DeletePolicyVersionShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting legal policy version via AccelByte API", fmt.Sprintf("Unable to delete legal policy version '%s' in namespace '%s', got error: %s", input.PolicyVersionID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteLegalPolicyVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "policy_id", "policy_version_id")
+}
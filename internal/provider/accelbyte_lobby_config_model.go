@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/AccelByte/accelbyte-go-sdk/lobby-sdk/pkg/lobbyclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteLobbyConfigModel is used by AccelByteLobbyConfigResource.
+type AccelByteLobbyConfigModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Can be set by user during resource creation; will otherwise retain the current settings in the AccelByte backend
+	AllowInviteNonConnectedUser      types.Bool   `tfsdk:"allow_invite_non_connected_user"`
+	AllowJoinPartyDuringMatchmaking  types.Bool   `tfsdk:"allow_join_party_during_matchmaking"`
+	AutoKickOnDisconnect             types.Bool   `tfsdk:"auto_kick_on_disconnect"`
+	AutoKickOnDisconnectDelay        types.Int64  `tfsdk:"auto_kick_on_disconnect_delay"`
+	CancelTicketOnDisconnect         types.Bool   `tfsdk:"cancel_ticket_on_disconnect"`
+	ChatRateLimitBurst               types.Int32  `tfsdk:"chat_rate_limit_burst"`
+	ChatRateLimitDuration            types.Int64  `tfsdk:"chat_rate_limit_duration"`
+	ConcurrentUsersLimit             types.Int32  `tfsdk:"concurrent_users_limit"`
+	DisableInvitationOnJoinParty     types.Bool   `tfsdk:"disable_invitation_on_join_party"`
+	EnableChat                       types.Bool   `tfsdk:"enable_chat"`
+	EntitlementCheck                 types.Bool   `tfsdk:"entitlement_check"`
+	EntitlementItemId                types.String `tfsdk:"entitlement_item_id"`
+	GeneralRateLimitBurst            types.Int32  `tfsdk:"general_rate_limit_burst"`
+	GeneralRateLimitDuration         types.Int64  `tfsdk:"general_rate_limit_duration"`
+	KeepPresenceActivityOnDisconnect types.Bool   `tfsdk:"keep_presence_activity_on_disconnect"`
+	MaxDsWaitTime                    types.Int64  `tfsdk:"max_ds_wait_time"`
+	MaxFriendsLimit                  types.Int32  `tfsdk:"max_friends_limit"`
+	MaxPartyMember                   types.Int32  `tfsdk:"max_party_member"`
+	ProfanityFilter                  types.Bool   `tfsdk:"profanity_filter"`
+	ReadyConsentTimeout              types.Int64  `tfsdk:"ready_consent_timeout"`
+	RequestMetadataMaxSize           types.Int32  `tfsdk:"request_metadata_max_size"`
+	UnregisterDelay                  types.Int64  `tfsdk:"unregister_delay"`
+}
+
+// Used by Create, Read and Update operations on the lobby configuration.
+// This copies data from the AccelByte API `config` to the TF state `data`.
+//
+//nolint:unparam
+func updateFromApiLobbyConfig(_ context.Context, data *AccelByteLobbyConfigModel, config *lobbyclientmodels.ModelsConfigReq) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Id = types.StringValue(data.Namespace.ValueString())
+
+	data.AllowInviteNonConnectedUser = types.BoolValue(config.AllowInviteNonConnectedUser)
+	data.AllowJoinPartyDuringMatchmaking = types.BoolValue(config.AllowJoinPartyDuringMatchmaking)
+	data.AutoKickOnDisconnect = types.BoolValue(config.AutoKickOnDisconnect)
+	data.AutoKickOnDisconnectDelay = types.Int64Value(config.AutoKickOnDisconnectDelay)
+	data.CancelTicketOnDisconnect = types.BoolValue(config.CancelTicketOnDisconnect)
+	data.ChatRateLimitBurst = types.Int32Value(config.ChatRateLimitBurst)
+	data.ChatRateLimitDuration = types.Int64Value(config.ChatRateLimitDuration)
+	data.ConcurrentUsersLimit = types.Int32Value(config.ConcurrentUsersLimit)
+	data.DisableInvitationOnJoinParty = types.BoolValue(config.DisableInvitationOnJoinParty)
+	data.EnableChat = types.BoolValue(config.EnableChat)
+	data.EntitlementCheck = types.BoolValue(config.EntitlementCheck)
+	data.EntitlementItemId = types.StringValue(config.EntitlementItemID)
+	data.GeneralRateLimitBurst = types.Int32Value(config.GeneralRateLimitBurst)
+	data.GeneralRateLimitDuration = types.Int64Value(config.GeneralRateLimitDuration)
+	data.KeepPresenceActivityOnDisconnect = types.BoolValue(config.KeepPresenceActivityOnDisconnect)
+	data.MaxDsWaitTime = types.Int64Value(config.MaxDSWaitTime)
+	data.MaxFriendsLimit = types.Int32Value(config.MaxFriendsLimit)
+	data.MaxPartyMember = types.Int32Value(config.MaxPartyMember)
+	data.ProfanityFilter = types.BoolValue(config.ProfanityFilter)
+	data.ReadyConsentTimeout = types.Int64Value(config.ReadyConsentTimeout)
+	data.RequestMetadataMaxSize = types.Int32Value(config.RequestMetadataMaxSize)
+	data.UnregisterDelay = types.Int64Value(config.UnregisterDelay)
+
+	return diags
+}
+
+// Used by Create/Update operations on the lobby configuration.
+// This reads from the TF plan `data` and returns an AccelByte API object.
+// The API replaces the entire configuration, so settings which are not known in the plan are copied from `current`.
+func toApiLobbyConfig(data AccelByteLobbyConfigModel, current *lobbyclientmodels.ModelsConfigReq) *lobbyclientmodels.ModelsConfigReq {
+
+	config := *current
+
+	if !data.AllowInviteNonConnectedUser.IsNull() && !data.AllowInviteNonConnectedUser.IsUnknown() {
+		config.AllowInviteNonConnectedUser = data.AllowInviteNonConnectedUser.ValueBool()
+	}
+	if !data.AllowJoinPartyDuringMatchmaking.IsNull() && !data.AllowJoinPartyDuringMatchmaking.IsUnknown() {
+		config.AllowJoinPartyDuringMatchmaking = data.AllowJoinPartyDuringMatchmaking.ValueBool()
+	}
+	if !data.AutoKickOnDisconnect.IsNull() && !data.AutoKickOnDisconnect.IsUnknown() {
+		config.AutoKickOnDisconnect = data.AutoKickOnDisconnect.ValueBool()
+	}
+	if !data.AutoKickOnDisconnectDelay.IsNull() && !data.AutoKickOnDisconnectDelay.IsUnknown() {
+		config.AutoKickOnDisconnectDelay = data.AutoKickOnDisconnectDelay.ValueInt64()
+	}
+	if !data.CancelTicketOnDisconnect.IsNull() && !data.CancelTicketOnDisconnect.IsUnknown() {
+		config.CancelTicketOnDisconnect = data.CancelTicketOnDisconnect.ValueBool()
+	}
+	if !data.ChatRateLimitBurst.IsNull() && !data.ChatRateLimitBurst.IsUnknown() {
+		config.ChatRateLimitBurst = data.ChatRateLimitBurst.ValueInt32()
+	}
+	if !data.ChatRateLimitDuration.IsNull() && !data.ChatRateLimitDuration.IsUnknown() {
+		config.ChatRateLimitDuration = data.ChatRateLimitDuration.ValueInt64()
+	}
+	if !data.ConcurrentUsersLimit.IsNull() && !data.ConcurrentUsersLimit.IsUnknown() {
+		config.ConcurrentUsersLimit = data.ConcurrentUsersLimit.ValueInt32()
+	}
+	if !data.DisableInvitationOnJoinParty.IsNull() && !data.DisableInvitationOnJoinParty.IsUnknown() {
+		config.DisableInvitationOnJoinParty = data.DisableInvitationOnJoinParty.ValueBool()
+	}
+	if !data.EnableChat.IsNull() && !data.EnableChat.IsUnknown() {
+		config.EnableChat = data.EnableChat.ValueBool()
+	}
+	if !data.EntitlementCheck.IsNull() && !data.EntitlementCheck.IsUnknown() {
+		config.EntitlementCheck = data.EntitlementCheck.ValueBool()
+	}
+	if !data.EntitlementItemId.IsNull() && !data.EntitlementItemId.IsUnknown() {
+		config.EntitlementItemID = data.EntitlementItemId.ValueString()
+	}
+	if !data.GeneralRateLimitBurst.IsNull() && !data.GeneralRateLimitBurst.IsUnknown() {
+		config.GeneralRateLimitBurst = data.GeneralRateLimitBurst.ValueInt32()
+	}
+	if !data.GeneralRateLimitDuration.IsNull() && !data.GeneralRateLimitDuration.IsUnknown() {
+		config.GeneralRateLimitDuration = data.GeneralRateLimitDuration.ValueInt64()
+	}
+	if !data.KeepPresenceActivityOnDisconnect.IsNull() && !data.KeepPresenceActivityOnDisconnect.IsUnknown() {
+		config.KeepPresenceActivityOnDisconnect = data.KeepPresenceActivityOnDisconnect.ValueBool()
+	}
+	if !data.MaxDsWaitTime.IsNull() && !data.MaxDsWaitTime.IsUnknown() {
+		config.MaxDSWaitTime = data.MaxDsWaitTime.ValueInt64()
+	}
+	if !data.MaxFriendsLimit.IsNull() && !data.MaxFriendsLimit.IsUnknown() {
+		config.MaxFriendsLimit = data.MaxFriendsLimit.ValueInt32()
+	}
+	if !data.MaxPartyMember.IsNull() && !data.MaxPartyMember.IsUnknown() {
+		config.MaxPartyMember = data.MaxPartyMember.ValueInt32()
+	}
+	if !data.ProfanityFilter.IsNull() && !data.ProfanityFilter.IsUnknown() {
+		config.ProfanityFilter = data.ProfanityFilter.ValueBool()
+	}
+	if !data.ReadyConsentTimeout.IsNull() && !data.ReadyConsentTimeout.IsUnknown() {
+		config.ReadyConsentTimeout = data.ReadyConsentTimeout.ValueInt64()
+	}
+	if !data.RequestMetadataMaxSize.IsNull() && !data.RequestMetadataMaxSize.IsUnknown() {
+		config.RequestMetadataMaxSize = data.RequestMetadataMaxSize.ValueInt32()
+	}
+	if !data.UnregisterDelay.IsNull() && !data.UnregisterDelay.IsUnknown() {
+		config.UnregisterDelay = data.UnregisterDelay.ValueInt64()
+	}
+
+	return &config
+}
+
+// Used by the Delete operation on the lobby configuration.
+// The API has no operation for resetting the configuration. Numeric settings are omitted from the request, so that
+// the Lobby service applies its own defaults; boolean settings are set to the defaults of a new namespace.
+func lobbyConfigDefaults() *lobbyclientmodels.ModelsConfigReq {
+	return &lobbyclientmodels.ModelsConfigReq{
+		AutoKickOnDisconnect: true,
+		EnableChat:           true,
+		ProfanityFilter:      true,
+	}
+}
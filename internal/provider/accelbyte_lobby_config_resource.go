@@ -0,0 +1,475 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/lobby-sdk/pkg/lobbyclient/config"
+	"github.com/AccelByte/accelbyte-go-sdk/lobby-sdk/pkg/lobbyclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/lobby"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteLobbyConfigResource{}
+var _ resource.ResourceWithImportState = &AccelByteLobbyConfigResource{}
+
+func NewAccelByteLobbyConfigResource() resource.Resource {
+	return &AccelByteLobbyConfigResource{}
+}
+
+// AccelByteLobbyConfigResource defines the resource implementation.
+type AccelByteLobbyConfigResource struct {
+	client *lobby.ConfigService
+}
+
+func (r *AccelByteLobbyConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lobby_config"
+}
+
+func (r *AccelByteLobbyConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the [lobby configuration](https://docs.accelbyte.io/gaming-services/services/play/lobby/) of a namespace. There is exactly one lobby configuration per namespace; use at most one of these resources per namespace.\n\nSettings which are not set retain their current values in the AccelByte backend. Destroying the resource resets the lobby configuration to its defaults.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace whose lobby is configured. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Lobby configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise retain the current settings in the AccelByte backend
+
+			"allow_invite_non_connected_user": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players can invite users who are not connected to the lobby to a party.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_join_party_during_matchmaking": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players can join a party while it is matchmaking.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_kick_on_disconnect": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players are removed from their party when they disconnect from the lobby.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_kick_on_disconnect_delay": schema.Int64Attribute{
+				MarkdownDescription: "Delay before a disconnected player is removed from their party, in seconds.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cancel_ticket_on_disconnect": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, a party's matchmaking ticket is cancelled when one of its members disconnects from the lobby.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"chat_rate_limit_burst": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of chat messages that a player can send within `chat_rate_limit_duration`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"chat_rate_limit_duration": schema.Int64Attribute{
+				MarkdownDescription: "Duration of the chat rate limit window.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"concurrent_users_limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of players that can be connected to the lobby at the same time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"disable_invitation_on_join_party": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players cannot be invited to a party while they are joining another party.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enable_chat": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, lobby chat is enabled.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entitlement_check": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players must own the entitlement `entitlement_item_id` to connect to the lobby.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"entitlement_item_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the item that players must own to connect to the lobby, when `entitlement_check` is enabled.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"general_rate_limit_burst": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of lobby requests that a player can send within `general_rate_limit_duration`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"general_rate_limit_duration": schema.Int64Attribute{
+				MarkdownDescription: "Duration of the general rate limit window.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"keep_presence_activity_on_disconnect": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, a player's presence activity is retained when they disconnect from the lobby.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_ds_wait_time": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time to wait for a dedicated server to become available.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_friends_limit": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of friends that a player can have.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"max_party_member": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of members in a party.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"profanity_filter": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, profanity is filtered from lobby chat.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ready_consent_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Time that party members have to confirm that they are ready for a match.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"request_metadata_max_size": schema.Int32Attribute{
+				MarkdownDescription: "Maximum size of request metadata, in bytes.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"unregister_delay": schema.Int64Attribute{
+				MarkdownDescription: "Delay before a disconnected player is unregistered from the lobby.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteLobbyConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.LobbyConfigService
+}
+
+func (r *AccelByteLobbyConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteLobbyConfigModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The lobby configuration always exists; creating the resource updates it
+	// Fetch the current configuration first, so that settings which are not set by the user retain their current values
+
+	getInput := &config.AdminGetConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+	}
+
+	current, err := r.client.AdminGetConfigV1Short(getInput)
+	if err != nil {
+		notFoundError := &config.AdminGetConfigV1NotFound{}
+		if !errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Error when reading lobby configuration via AccelByte API", fmt.Sprintf("Unable to read lobby configuration in namespace '%s', got error: %s", getInput.Namespace, err))
+			return
+		}
+		// The namespace has no lobby configuration yet; start from the defaults
+		current = lobbyConfigDefaults()
+	}
+
+	tflog.Trace(ctx, "Creating lobby configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &config.AdminUpdateConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiLobbyConfig(data, current),
+	}
+
+	lobbyConfig, err := r.client.AdminUpdateConfigV1Short(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating lobby configuration via AccelByte API", fmt.Sprintf("Unable to create lobby configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiLobbyConfig(ctx, &data, lobbyConfig)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLobbyConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteLobbyConfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &config.AdminGetConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+	}
+
+	lobbyConfig, err := r.client.AdminGetConfigV1Short(input)
+	if err != nil {
+		notFoundError := &config.AdminGetConfigV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading lobby configuration via AccelByte API", fmt.Sprintf("Unable to read lobby configuration in namespace '%s', got error: %s", input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read lobby configuration from AccelByte API", map[string]interface{}{
+		"namespace":   data.Namespace,
+		"lobbyConfig": lobbyConfig,
+	})
+
+	resp.Diagnostics.Append(updateFromApiLobbyConfig(ctx, &data, lobbyConfig)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLobbyConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteLobbyConfigModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating lobby configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	// All settings are known in the plan, since unset settings retain their values from the TF state
+	input := &config.AdminUpdateConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiLobbyConfig(data, &lobbyclientmodels.ModelsConfigReq{}),
+	}
+
+	lobbyConfig, err := r.client.AdminUpdateConfigV1Short(input)
+	if err != nil {
+		notFoundError := &config.AdminUpdateConfigV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Lobby configuration does not exist in namespace '%s'", input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating lobby configuration via AccelByte API", fmt.Sprintf("Unable to update lobby configuration in namespace '%s', got error: %s", input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiLobbyConfig(ctx, &data, lobbyConfig)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteLobbyConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteLobbyConfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Resetting lobby configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	// The lobby configuration cannot be deleted; reset it to its defaults instead
+	input := &config.AdminUpdateConfigV1Params{
+		Namespace: data.Namespace.ValueString(),
+		Body:      lobbyConfigDefaults(),
+	}
+	_, err := r.client.AdminUpdateConfigV1Short(input)
+	if err != nil {
+		notFoundError := &config.AdminUpdateConfigV1NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting lobby configuration via AccelByte API", fmt.Sprintf("Unable to reset lobby configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteLobbyConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
@@ -6,8 +6,10 @@ package provider
 import (
 	"context"
 	"reflect"
+	"time"
 
 	"github.com/AccelByte/accelbyte-go-sdk/match2-sdk/pkg/match2clientmodels"
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -76,6 +78,42 @@ func listValueFromEvenIfNil(ctx context.Context, elementType attr.Type, elements
 	}
 }
 
+// Replacement for types.SetValueFrom(), which guarantees to return a set object,
+// even if the input elements is an empty slice or nil.
+func setValueFromEvenIfNil(ctx context.Context, elementType attr.Type, elements any) (basetypes.SetValue, diag.Diagnostics) {
+	if !reflect.ValueOf(elements).IsNil() {
+		return types.SetValueFrom(ctx, elementType, elements)
+	} else {
+		return types.SetValueFrom(ctx, elementType, []int{})
+	}
+}
+
+// Replacement for types.MapValueFrom(), which guarantees to return a map object,
+// even if the input elements is an empty map or nil.
+func mapValueFromEvenIfNil(ctx context.Context, elementType attr.Type, elements any) (basetypes.MapValue, diag.Diagnostics) {
+	if !reflect.ValueOf(elements).IsNil() {
+		return types.MapValueFrom(ctx, elementType, elements)
+	} else {
+		return types.MapValueFrom(ctx, elementType, map[string]int{})
+	}
+}
+
+// The API may reformat timestamps (for example, by adding fractional seconds or changing time zone).
+// Retain the user's formatting of a timestamp in the TF state `value` as long as it refers to the same point in time.
+func fromApiTimestamp(value types.String, apiValue *strfmt.DateTime) types.String {
+	if apiValue == nil || time.Time(*apiValue).IsZero() {
+		return types.StringNull()
+	}
+
+	if !value.IsNull() && !value.IsUnknown() {
+		if parsed, err := time.Parse(time.RFC3339, value.ValueString()); err == nil && parsed.Equal(time.Time(*apiValue)) {
+			return value
+		}
+	}
+
+	return types.StringValue(time.Time(*apiValue).UTC().Format(time.RFC3339))
+}
+
 // Used by Create, Read and Update operations on Match Pools.
 // This copies data from the AccelByte API `pool` to the TF state `data`.
 //
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFromApiTimestamp(t *testing.T) {
+	apiValue := strfmt.DateTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))
+	zero := strfmt.DateTime(time.Time{})
+
+	tests := []struct {
+		name     string
+		value    types.String
+		apiValue *strfmt.DateTime
+		want     types.String
+	}{
+		{name: "no API value", value: types.StringValue("2025-01-01T12:00:00Z"), apiValue: nil, want: types.StringNull()},
+		{name: "zero API value", value: types.StringValue("2025-01-01T12:00:00Z"), apiValue: &zero, want: types.StringNull()},
+		{name: "no prior value", value: types.StringNull(), apiValue: &apiValue, want: types.StringValue("2025-01-01T12:00:00Z")},
+		{name: "unknown prior value", value: types.StringUnknown(), apiValue: &apiValue, want: types.StringValue("2025-01-01T12:00:00Z")},
+		{name: "same instant in another time zone", value: types.StringValue("2025-01-01T13:00:00+01:00"), apiValue: &apiValue, want: types.StringValue("2025-01-01T13:00:00+01:00")},
+		{name: "same instant with fractional seconds", value: types.StringValue("2025-01-01T12:00:00.000Z"), apiValue: &apiValue, want: types.StringValue("2025-01-01T12:00:00.000Z")},
+		{name: "different instant", value: types.StringValue("2025-01-02T12:00:00Z"), apiValue: &apiValue, want: types.StringValue("2025-01-01T12:00:00Z")},
+		{name: "unparseable prior value", value: types.StringValue("tomorrow"), apiValue: &apiValue, want: types.StringValue("2025-01-01T12:00:00Z")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := fromApiTimestamp(test.value, test.apiValue)
+			if !got.Equal(test.want) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
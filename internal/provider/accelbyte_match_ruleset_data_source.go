@@ -10,6 +10,7 @@ import (
 
 	"github.com/AccelByte/accelbyte-go-sdk/match2-sdk/pkg/match2client/rule_sets"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/match2"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -66,6 +67,7 @@ func (d *AccelByteMatchRuleSetDataSource) Schema(ctx context.Context, req dataso
 
 			"configuration": schema.StringAttribute{
 				MarkdownDescription: "Matchmaking ruleset configuration in JSON format. See [ruleset docs](https://docs.accelbyte.io/gaming-services/services/play/matchmaking/configuring-match-rulesets/#overview).",
+				CustomType:          jsontypes.NormalizedType{},
 				Computed:            true,
 			},
 		},
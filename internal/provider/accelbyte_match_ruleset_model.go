@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/AccelByte/accelbyte-go-sdk/match2-sdk/pkg/match2clientmodels"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/pkg/errors"
@@ -27,7 +28,9 @@ type AccelByteMatchRuleSetModel struct {
 
 	EnableCustomMatchFunction types.Bool `tfsdk:"enable_custom_match_function"`
 
-	Configuration types.String `tfsdk:"configuration"`
+	// The configuration is compared semantically, so that whitespace and key ordering differences between
+	// the user's JSON and the JSON returned by the API do not result in a diff
+	Configuration jsontypes.Normalized `tfsdk:"configuration"`
 }
 
 // Used by Create, Read and Update operations on Match Rulesets.
@@ -46,7 +49,7 @@ func updateFromApiMatchRuleSet(_ context.Context, data *AccelByteMatchRuleSetMod
 		return diags, errors.Wrap(err, "Unable to convert API's Match RuleSet's data to JSON: "+fmt.Sprintf("%#v", matchRuleSet.Data))
 	}
 
-	data.Configuration = types.StringValue(string(configurationJson))
+	data.Configuration = jsontypes.NewNormalizedValue(string(configurationJson))
 	return diags, nil
 }
 
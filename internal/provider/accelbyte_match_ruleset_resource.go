@@ -11,6 +11,7 @@ import (
 
 	"github.com/AccelByte/accelbyte-go-sdk/match2-sdk/pkg/match2client/rule_sets"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/match2"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -85,6 +86,7 @@ func (r *AccelByteMatchRuleSetResource) Schema(ctx context.Context, req resource
 
 			"configuration": schema.StringAttribute{
 				MarkdownDescription: "Matchmaking ruleset configuration in JSON format. See [ruleset docs](https://docs.accelbyte.io/gaming-services/services/play/matchmaking/configuring-match-rulesets/#overview).",
+				CustomType:          jsontypes.NormalizedType{},
 				Required:            true,
 			},
 		},
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/AccelByte/accelbyte-go-sdk/basic-sdk/pkg/basicclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteNamespaceModel is used by AccelByteNamespaceResource.
+type AccelByteNamespaceModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	DisplayName types.String `tfsdk:"display_name"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Active types.Bool `tfsdk:"active"`
+
+	// Computed during Read() operation
+	Type            types.String `tfsdk:"type"`
+	Status          types.String `tfsdk:"status"`
+	ParentNamespace types.String `tfsdk:"parent_namespace"`
+}
+
+// Used by Create, Read and Update operations on Namespaces.
+// This copies data from the AccelByte API `namespace` and `namespaceContext` to the TF state `data`.
+func updateFromApiNamespace(ctx context.Context, data *AccelByteNamespaceModel, namespace *basicclientmodels.NamespaceInfo, namespaceContext *basicclientmodels.NamespaceContext) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*namespace.Namespace)
+	data.Id = types.StringValue(*namespace.Namespace)
+
+	data.DisplayName = types.StringValue(*namespace.DisplayName)
+	data.Active = types.BoolValue(namespace.Status == basicclientmodels.NamespaceInfoStatusACTIVE)
+
+	data.Type = types.StringValue(namespaceContext.Type)
+	data.Status = types.StringValue(namespace.Status)
+	data.ParentNamespace = types.StringValue(namespace.ParentNamespace)
+
+	return diags
+}
+
+// Used by Create/Update operations on Namespaces.
+// This reads from the TF state `data` and returns the namespace status to send to the AccelByte API.
+func toApiNamespaceStatus(data AccelByteNamespaceModel) *string {
+	status := basicclientmodels.NamespaceStatusUpdateStatusINACTIVE
+	if data.Active.ValueBool() {
+		status = basicclientmodels.NamespaceStatusUpdateStatusACTIVE
+	}
+	return &status
+}
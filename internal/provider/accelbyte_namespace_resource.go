@@ -0,0 +1,366 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/basic-sdk/pkg/basicclient/namespace"
+	"github.com/AccelByte/accelbyte-go-sdk/basic-sdk/pkg/basicclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/basic"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteNamespaceResource{}
+var _ resource.ResourceWithImportState = &AccelByteNamespaceResource{}
+
+func NewAccelByteNamespaceResource() resource.Resource {
+	return &AccelByteNamespaceResource{}
+}
+
+// AccelByteNamespaceResource defines the resource implementation.
+type AccelByteNamespaceResource struct {
+	client *basic.NamespaceService
+}
+
+func (r *AccelByteNamespaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace"
+}
+
+func (r *AccelByteNamespaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [game namespace](https://docs.accelbyte.io/gaming-services/getting-started/setup-game-studio/). The namespace is created under the publisher namespace of the user that the provider authenticates as.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from this
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Name of the game namespace. Lowercase characters or digits. Max 48 characters in length. Changing this forces the namespace to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+$`), "must consist of lowercase characters or digits"),
+					stringvalidator.LengthAtMost(48),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Namespace identifier. Same as `namespace`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the namespace.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "If set to `false`, the namespace will be inactive.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+
+			// Computed during Read() operation
+
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of namespace, for example `Game` or `Publisher`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of namespace. Either `ACTIVE` or `INACTIVE`.",
+				Computed:            true,
+			},
+			"parent_namespace": schema.StringAttribute{
+				MarkdownDescription: "Publisher namespace which contains this namespace.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteNamespaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.BasicNamespaceService
+}
+
+func (r *AccelByteNamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteNamespaceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating namespace via AccelByte API", map[string]interface{}{
+		"namespace":   data.Namespace,
+		"displayName": data.DisplayName,
+	})
+
+	createInput := &namespace.CreateNamespaceParams{
+		Body: &basicclientmodels.NamespaceCreate{
+			Namespace:   data.Namespace.ValueStringPointer(),
+			DisplayName: data.DisplayName.ValueStringPointer(),
+		},
+	}
+
+	_, err := r.client.CreateNamespaceShort(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating namespace via AccelByte API", fmt.Sprintf("Unable to create namespace '%s', got error: %s", *createInput.Body.Namespace, err))
+		return
+	}
+
+	// New namespaces are active; deactivate it if requested
+
+	if !data.Active.ValueBool() {
+		statusInput := &namespace.ChangeNamespaceStatusParams{
+			Namespace: data.Namespace.ValueString(),
+			Body: &basicclientmodels.NamespaceStatusUpdate{
+				Status: toApiNamespaceStatus(data),
+			},
+		}
+
+		_, err = r.client.ChangeNamespaceStatusShort(statusInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Error when updating namespace status via AccelByte API", fmt.Sprintf("Unable to update status of namespace '%s', got error: %s", statusInput.Namespace, err))
+			return
+		}
+	}
+
+	// Fetch namespace immediately after creating it, so we can get the computed values
+
+	namespaceInfo, namespaceContext, err := r.getNamespace(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading namespace via AccelByte API", fmt.Sprintf("Unable to read namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiNamespace(ctx, &data, namespaceInfo, namespaceContext)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteNamespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteNamespaceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespaceInfo, namespaceContext, err := r.getNamespace(data.Namespace.ValueString())
+	if err != nil {
+		notFoundError := &namespace.GetNamespaceNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading namespace via AccelByte API", fmt.Sprintf("Unable to read namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+			return
+		}
+	}
+
+	if namespaceInfo.Status == basicclientmodels.NamespaceInfoStatusDELETED {
+		// The namespace has been deleted, but is still returned by the AccelByte backend
+		// Treat this the same as if the namespace did not exist
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read namespace from AccelByte API", map[string]interface{}{
+		"namespace":        data.Namespace,
+		"namespaceInfo":    namespaceInfo,
+		"namespaceContext": namespaceContext,
+	})
+
+	resp.Diagnostics.Append(updateFromApiNamespace(ctx, &data, namespaceInfo, namespaceContext)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteNamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteNamespaceModel
+	var state AccelByteNamespaceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating namespace via AccelByte API", map[string]interface{}{
+		"namespace":   data.Namespace,
+		"displayName": data.DisplayName,
+		"active":      data.Active,
+	})
+
+	if !data.DisplayName.Equal(state.DisplayName) {
+		input := &namespace.UpdateNamespaceParams{
+			Namespace: data.Namespace.ValueString(),
+			Body: &basicclientmodels.NamespaceUpdate{
+				DisplayName: data.DisplayName.ValueStringPointer(),
+			},
+		}
+
+		_, err := r.client.UpdateNamespaceShort(input)
+		if err != nil {
+			notFoundError := &namespace.UpdateNamespaceNotFound{}
+			if errors.As(err, &notFoundError) {
+				// The resource does not exist in the AccelByte backend
+				// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+				resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Namespace '%s' does not exist", input.Namespace))
+				return
+			} else {
+				// Failed to update the resource in the AccelByte backend
+				// The backend refused our update operation; we should abort
+				resp.Diagnostics.AddError("Error when updating namespace via AccelByte API", fmt.Sprintf("Unable to update namespace '%s', got error: %s", input.Namespace, err))
+				return
+			}
+		}
+	}
+
+	if !data.Active.Equal(state.Active) {
+		statusInput := &namespace.ChangeNamespaceStatusParams{
+			Namespace: data.Namespace.ValueString(),
+			Body: &basicclientmodels.NamespaceStatusUpdate{
+				Status: toApiNamespaceStatus(data),
+			},
+		}
+
+		_, err := r.client.ChangeNamespaceStatusShort(statusInput)
+		if err != nil {
+			resp.Diagnostics.AddError("Error when updating namespace status via AccelByte API", fmt.Sprintf("Unable to update status of namespace '%s', got error: %s", statusInput.Namespace, err))
+			return
+		}
+	}
+
+	// Fetch namespace after updating it, so we can get the computed values
+
+	namespaceInfo, namespaceContext, err := r.getNamespace(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading namespace via AccelByte API", fmt.Sprintf("Unable to read namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiNamespace(ctx, &data, namespaceInfo, namespaceContext)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteNamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteNamespaceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting namespace via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &namespace.DeleteNamespaceParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	_, err := r.client.DeleteNamespaceShort(input)
+	if err != nil {
+		notFoundError := &namespace.DeleteNamespaceNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting namespace via AccelByte API", fmt.Sprintf("Unable to delete namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteNamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
+
+// Fetch a namespace along with its context, regardless of whether it is active or not
+func (r *AccelByteNamespaceResource) getNamespace(namespaceName string) (*basicclientmodels.NamespaceInfo, *basicclientmodels.NamespaceContext, error) {
+
+	activeOnly := false
+	namespaceInfo, err := r.client.GetNamespaceShort(&namespace.GetNamespaceParams{
+		Namespace:  namespaceName,
+		ActiveOnly: &activeOnly,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	namespaceContext, err := r.client.GetNamespaceContextShort(&namespace.GetNamespaceContextParams{
+		Namespace: namespaceName,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return namespaceInfo, namespaceContext, nil
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AccelBytePlatformCampaignModel is used by AccelBytePlatformCampaignResource.
+type AccelBytePlatformCampaignModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	CampaignId types.String `tfsdk:"campaign_id"`
+	Id         types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Name  types.String `tfsdk:"name"`
+	Items types.List   `tfsdk:"items"` // []AccelBytePlatformCampaignItemModel
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Description                      types.String `tfsdk:"description"`
+	RedeemType                       types.String `tfsdk:"redeem_type"`
+	Status                           types.String `tfsdk:"status"`
+	MaxRedeemCountPerCode            types.Int32  `tfsdk:"max_redeem_count_per_code"`
+	MaxRedeemCountPerCodePerUser     types.Int32  `tfsdk:"max_redeem_count_per_code_per_user"`
+	MaxRedeemCountPerCampaignPerUser types.Int32  `tfsdk:"max_redeem_count_per_campaign_per_user"`
+	MaxSaleCount                     types.Int32  `tfsdk:"max_sale_count"`
+	RedeemStart                      types.String `tfsdk:"redeem_start"`
+	RedeemEnd                        types.String `tfsdk:"redeem_end"`
+	Tags                             types.Set    `tfsdk:"tags"` // []string
+
+	// Can be set by user; controls generation of redeem codes
+	CodeBatch types.Object `tfsdk:"code_batch"` // AccelBytePlatformCampaignCodeBatchModel
+
+	// Computed during Read() operation
+	Codes types.List `tfsdk:"codes"` // []string
+}
+
+var AccelBytePlatformCampaignItemModelAttributeTypes = map[string]attr.Type{
+	"item_id":                 types.StringType,
+	"item_name":               types.StringType,
+	"quantity":                types.Int32Type,
+	"extra_subscription_days": types.Int32Type,
+}
+
+type AccelBytePlatformCampaignItemModel struct {
+	ItemId                types.String `tfsdk:"item_id"`
+	ItemName              types.String `tfsdk:"item_name"`
+	Quantity              types.Int32  `tfsdk:"quantity"`
+	ExtraSubscriptionDays types.Int32  `tfsdk:"extra_subscription_days"`
+}
+
+var AccelBytePlatformCampaignCodeBatchModelAttributeTypes = map[string]attr.Type{
+	"batch_name": types.StringType,
+	"quantity":   types.Int32Type,
+}
+
+type AccelBytePlatformCampaignCodeBatchModel struct {
+	BatchName types.String `tfsdk:"batch_name"`
+	Quantity  types.Int32  `tfsdk:"quantity"`
+}
+
+// Used by Create, Read and Update operations on campaigns.
+// This copies data from the AccelByte API `campaign` to the TF state `data`.
+// The code batch and codes are not part of the campaign; these are handled by updateFromApiPlatformCampaignCodes().
+func updateFromApiPlatformCampaign(ctx context.Context, data *AccelBytePlatformCampaignModel, campaign *platformclientmodels.CampaignInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*campaign.Namespace)
+	data.CampaignId = types.StringValue(*campaign.ID)
+	data.Id = types.StringValue(computePlatformCampaignId(*campaign.Namespace, *campaign.ID))
+
+	data.Name = types.StringPointerValue(campaign.Name)
+
+	items := []AccelBytePlatformCampaignItemModel{}
+	for _, item := range campaign.Items {
+		items = append(items, AccelBytePlatformCampaignItemModel{
+			ItemId:                types.StringPointerValue(item.ItemID),
+			ItemName:              types.StringPointerValue(item.ItemName),
+			Quantity:              types.Int32Value(item.Quantity),
+			ExtraSubscriptionDays: fromApiPlatformItemOptionalInt32(item.ExtraSubscriptionDays),
+		})
+	}
+	itemsList, itemsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelBytePlatformCampaignItemModelAttributeTypes}, items)
+	data.Items = itemsList
+	diags.Append(itemsDiags...)
+
+	data.Description = types.StringValue(campaign.Description)
+	data.RedeemType = types.StringPointerValue(campaign.RedeemType)
+	data.Status = types.StringPointerValue(campaign.Status)
+	data.MaxRedeemCountPerCode = types.Int32PointerValue(campaign.MaxRedeemCountPerCode)
+	data.MaxRedeemCountPerCodePerUser = types.Int32PointerValue(campaign.MaxRedeemCountPerCodePerUser)
+	data.MaxRedeemCountPerCampaignPerUser = types.Int32PointerValue(campaign.MaxRedeemCountPerCampaignPerUser)
+	data.MaxSaleCount = types.Int32PointerValue(campaign.MaxSaleCount)
+	data.RedeemStart = fromApiTimestamp(data.RedeemStart, campaign.RedeemStart)
+	data.RedeemEnd = fromApiTimestamp(data.RedeemEnd, campaign.RedeemEnd)
+
+	tags, tagsDiags := setValueFromEvenIfNil(ctx, types.StringType, campaign.Tags)
+	data.Tags = tags
+	diags.Append(tagsDiags...)
+
+	return diags
+}
+
+// Used by Create, Read and Update operations on campaigns.
+// This copies the active codes in the campaign's code batch to the TF state `data`.
+// The quantity of the code batch reflects the number of codes that are still active.
+func updateFromApiPlatformCampaignCodes(ctx context.Context, data *AccelBytePlatformCampaignModel, codes []*platformclientmodels.CodeInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	codeValues := []string{}
+	for _, code := range codes {
+		codeValues = append(codeValues, *code.Value)
+	}
+
+	codesList, codesDiags := types.ListValueFrom(ctx, types.StringType, codeValues)
+	data.Codes = codesList
+	diags.Append(codesDiags...)
+
+	if !data.CodeBatch.IsNull() && !data.CodeBatch.IsUnknown() {
+		var codeBatch AccelBytePlatformCampaignCodeBatchModel
+		diags.Append(data.CodeBatch.As(ctx, &codeBatch, basetypes.ObjectAsOptions{})...)
+
+		codeBatch.Quantity = types.Int32Value(int32(len(codeValues)))
+
+		codeBatchObject, codeBatchDiags := types.ObjectValueFrom(ctx, AccelBytePlatformCampaignCodeBatchModelAttributeTypes, codeBatch)
+		data.CodeBatch = codeBatchObject
+		diags.Append(codeBatchDiags...)
+	}
+
+	return diags
+}
+
+// Used by the Create operation on campaigns.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformCampaignCreate(ctx context.Context, data AccelBytePlatformCampaignModel) (*platformclientmodels.CampaignCreate, diag.Diagnostics) {
+
+	apiCampaign, diags := toApiPlatformCampaignUpdate(ctx, data)
+
+	return &platformclientmodels.CampaignCreate{
+		Name:                             apiCampaign.Name,
+		Description:                      apiCampaign.Description,
+		Items:                            apiCampaign.Items,
+		RedeemType:                       apiCampaign.RedeemType,
+		Status:                           apiCampaign.Status,
+		MaxRedeemCountPerCode:            apiCampaign.MaxRedeemCountPerCode,
+		MaxRedeemCountPerCodePerUser:     apiCampaign.MaxRedeemCountPerCodePerUser,
+		MaxRedeemCountPerCampaignPerUser: apiCampaign.MaxRedeemCountPerCampaignPerUser,
+		MaxSaleCount:                     apiCampaign.MaxSaleCount,
+		RedeemStart:                      apiCampaign.RedeemStart,
+		RedeemEnd:                        apiCampaign.RedeemEnd,
+		Tags:                             apiCampaign.Tags,
+		Type:                             platformclientmodels.CampaignCreateTypeREDEMPTION,
+	}, diags
+}
+
+// Used by Create, Update and Delete operations on campaigns.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformCampaignUpdate(ctx context.Context, data AccelBytePlatformCampaignModel) (*platformclientmodels.CampaignUpdate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	items := []AccelBytePlatformCampaignItemModel{}
+	diags.Append(data.Items.ElementsAs(ctx, &items, false)...)
+
+	apiItems := []*platformclientmodels.RedeemableItem{}
+	for _, item := range items {
+		apiItems = append(apiItems, &platformclientmodels.RedeemableItem{
+			ItemID:                item.ItemId.ValueStringPointer(),
+			ItemName:              item.ItemName.ValueStringPointer(),
+			Quantity:              item.Quantity.ValueInt32(),
+			ExtraSubscriptionDays: item.ExtraSubscriptionDays.ValueInt32(),
+		})
+	}
+
+	tags := []string{}
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	redeemStart, err := toApiPlatformItemTimestamp(data.RedeemStart)
+	if err != nil {
+		diags.AddAttributeError(path.Root("redeem_start"), "Invalid timestamp", fmt.Sprintf("Unable to parse redeem start, got error: %s", err))
+	}
+
+	redeemEnd, err := toApiPlatformItemTimestamp(data.RedeemEnd)
+	if err != nil {
+		diags.AddAttributeError(path.Root("redeem_end"), "Invalid timestamp", fmt.Sprintf("Unable to parse redeem end, got error: %s", err))
+	}
+
+	return &platformclientmodels.CampaignUpdate{
+		Name:                             data.Name.ValueStringPointer(),
+		Description:                      data.Description.ValueString(),
+		Items:                            apiItems,
+		RedeemType:                       data.RedeemType.ValueString(),
+		Status:                           data.Status.ValueString(),
+		MaxRedeemCountPerCode:            data.MaxRedeemCountPerCode.ValueInt32(),
+		MaxRedeemCountPerCodePerUser:     data.MaxRedeemCountPerCodePerUser.ValueInt32(),
+		MaxRedeemCountPerCampaignPerUser: data.MaxRedeemCountPerCampaignPerUser.ValueInt32(),
+		MaxSaleCount:                     data.MaxSaleCount.ValueInt32(),
+		RedeemStart:                      redeemStart,
+		RedeemEnd:                        redeemEnd,
+		Tags:                             tags,
+	}, diags
+}
+
+func computePlatformCampaignId(namespace string, campaignId string) string {
+	return fmt.Sprintf("%s/%s", namespace, campaignId)
+}
@@ -0,0 +1,661 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/campaign"
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformCampaignResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformCampaignResource{}
+var _ resource.ResourceWithModifyPlan = &AccelBytePlatformCampaignResource{}
+var _ resource.ResourceWithValidateConfig = &AccelBytePlatformCampaignResource{}
+
+func NewAccelBytePlatformCampaignResource() resource.Resource {
+	return &AccelBytePlatformCampaignResource{}
+}
+
+// AccelBytePlatformCampaignResource defines the resource implementation.
+type AccelBytePlatformCampaignResource struct {
+	client *platform.CampaignService
+}
+
+// Number of codes to retrieve per request when listing the codes in a batch.
+const platformCampaignCodesPageSize = 100
+
+func (r *AccelBytePlatformCampaignResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_campaign"
+}
+
+func (r *AccelBytePlatformCampaignResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [campaign](https://docs.accelbyte.io/gaming-services/services/monetization/campaigns/). A campaign grants items to players who redeem one of its codes.\n\nSet `code_batch` to have the resource generate a batch of redeem codes; the generated codes are available in the sensitive `codes` attribute. Changing `code_batch` disables all codes in the previous batch and generates a new batch.\n\nCampaigns cannot be deleted. Destroying this resource marks the campaign as `INACTIVE` and removes it from the Terraform state.\n\nIf the campaign is created but its codes cannot be generated, a warning is reported and the codes are generated during the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the campaign. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"campaign_id": schema.StringAttribute{
+				MarkdownDescription: "Campaign ID, as assigned by the Platform service when the campaign was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Campaign identifier, on the format `{{namespace}}/{{campaign_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the campaign. Must be unique within the namespace. Uppercase characters, lowercase characters, digits, and the separators `'`, `,`, `.`, `-` and space; must start and end with a character or digit. Max 48 characters in length.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 48),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9',.\- ]*[A-Za-z0-9])?$`), "must start and end with a character or digit, and contain only characters, digits, and the separators ', . - or space"),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "Items which are granted when a code is redeemed.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"item_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the item to grant.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"item_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the item to grant.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"quantity": schema.Int32Attribute{
+							MarkdownDescription: "Number of items to grant.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"extra_subscription_days": schema.Int32Attribute{
+							MarkdownDescription: "Number of extra days to grant, if the item is a subscription.",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the campaign. Max 1024 characters in length.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			"redeem_type": schema.StringAttribute{
+				MarkdownDescription: "What redeeming a code does. Allowed values: `ITEM`. Default: `ITEM`. Discount campaigns are not supported by this resource.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(platformclientmodels.CampaignCreateRedeemTypeITEM),
+				Validators: []validator.String{
+					stringvalidator.OneOf(platformclientmodels.CampaignCreateRedeemTypeITEM),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the campaign. Codes can only be redeemed while the campaign is active. Allowed values: `ACTIVE`, `INACTIVE`. Default: `ACTIVE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(platformclientmodels.CampaignCreateStatusACTIVE),
+				Validators: []validator.String{
+					stringvalidator.OneOf(platformclientmodels.CampaignCreateStatusACTIVE, platformclientmodels.CampaignCreateStatusINACTIVE),
+				},
+			},
+			"max_redeem_count_per_code": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of times each code can be redeemed, across all players. `-1` means no limit. Default: `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(1),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(-1),
+					int32validator.NoneOf(0),
+				},
+			},
+			"max_redeem_count_per_code_per_user": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of times each player can redeem each code. `-1` means no limit. Default: `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(1),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(-1),
+					int32validator.NoneOf(0),
+				},
+			},
+			"max_redeem_count_per_campaign_per_user": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of codes in the campaign that each player can redeem. `-1` means no limit. Default: `1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(1),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(-1),
+					int32validator.NoneOf(0),
+				},
+			},
+			"max_sale_count": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of codes that can be sold. `-1` means no limit. Default: `-1`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(-1),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(-1),
+					int32validator.NoneOf(0),
+				},
+			},
+			"redeem_start": schema.StringAttribute{
+				MarkdownDescription: "Time from which codes can be redeemed, as an RFC3339 timestamp; for example `2025-01-01T00:00:00Z`. If not set, codes can be redeemed immediately.",
+				Optional:            true,
+			},
+			"redeem_end": schema.StringAttribute{
+				MarkdownDescription: "Time after which codes can no longer be redeemed, as an RFC3339 timestamp; for example `2025-02-01T00:00:00Z`. If not set, codes do not expire.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the campaign.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			// Can be set by user; controls generation of redeem codes
+
+			"code_batch": schema.SingleNestedAttribute{
+				MarkdownDescription: "Batch of redeem codes to generate for the campaign. Changing this disables all codes in the previous batch and generates a new batch. If not set, no codes are generated.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"batch_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the batch. Must not start or end with whitespace. Min 3, max 60 characters in length.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(3, 60),
+							stringvalidator.RegexMatches(regexp.MustCompile(`^\S(.*\S)?$`), "must not start or end with whitespace"),
+						},
+					},
+					"quantity": schema.Int32Attribute{
+						MarkdownDescription: "Number of codes to generate. If codes in the batch are disabled outside of Terraform, the number of remaining active codes no longer matches this, and a new batch will be generated.",
+						Required:            true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+			},
+
+			// Computed during Read() operation
+
+			"codes": schema.ListAttribute{
+				MarkdownDescription: "Active redeem codes in the code batch. Empty if `code_batch` is not set.",
+				ElementType:         types.StringType,
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformCampaignResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelBytePlatformCampaignModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attributeName, value := range map[string]types.String{"redeem_start": data.RedeemStart, "redeem_end": data.RedeemEnd} {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Invalid attribute value",
+				fmt.Sprintf("Attribute `%s` must be an RFC3339 timestamp, got error: %s", attributeName, err),
+			)
+		}
+	}
+}
+
+func (r *AccelBytePlatformCampaignResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformCampaignService
+}
+
+func (r *AccelBytePlatformCampaignResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// There is nothing to plan for codes when the resource is being created or destroyed
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state AccelBytePlatformCampaignModel
+	var plan AccelBytePlatformCampaignModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The codes only change when a new batch is generated
+	if plan.CodeBatch.Equal(state.CodeBatch) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("codes"), state.Codes)...)
+	}
+}
+
+func (r *AccelBytePlatformCampaignResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformCampaignModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCampaign, apiCampaignDiags := toApiPlatformCampaignCreate(ctx, data)
+	resp.Diagnostics.Append(apiCampaignDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating campaign via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"name":      data.Name,
+	})
+
+	input := &campaign.CreateCampaignParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiCampaign,
+	}
+
+	createdCampaign, err := r.client.CreateCampaignShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating campaign via AccelByte API", fmt.Sprintf("Unable to create campaign '%s' in namespace '%s', got error: %s", data.Name.ValueString(), input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformCampaign(ctx, &data, createdCampaign)...)
+	data.Codes = types.ListValueMust(types.StringType, []attr.Value{})
+
+	// Save the campaign into Terraform state before generating codes, so that the campaign is tracked even if code generation fails
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Campaigns cannot be deleted; failing here would taint the campaign, and each replacement would leave another inactive campaign behind
+	// Report a failed code generation as a warning instead; the next refresh finds no active codes in the batch, and the next apply generates it in place
+	generateDiags := r.generateCodes(ctx, data)
+	if generateDiags.HasError() {
+		for _, generateDiag := range generateDiags {
+			resp.Diagnostics.AddWarning(generateDiag.Summary(), generateDiag.Detail())
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(r.readCodes(ctx, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Generates the code batch described by `data`, if any.
+func (r *AccelBytePlatformCampaignResource) generateCodes(ctx context.Context, data AccelBytePlatformCampaignModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	if data.CodeBatch.IsNull() {
+		return diags
+	}
+
+	var codeBatch AccelBytePlatformCampaignCodeBatchModel
+	diags.Append(data.CodeBatch.As(ctx, &codeBatch, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Trace(ctx, "Generating campaign codes via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"campaignId": data.CampaignId,
+		"batchName":  codeBatch.BatchName,
+		"quantity":   codeBatch.Quantity,
+	})
+
+	input := &campaign.CreateCodesParams{
+		Namespace:  data.Namespace.ValueString(),
+		CampaignID: data.CampaignId.ValueString(),
+		Body: &platformclientmodels.CodeCreate{
+			BatchName: codeBatch.BatchName.ValueString(),
+			Quantity:  codeBatch.Quantity.ValueInt32(),
+		},
+	}
+
+	_, err := r.client.CreateCodesShort(input)
+	if err != nil {
+		diags.AddError("Error when generating campaign codes via AccelByte API", fmt.Sprintf("Unable to generate code batch '%s' for campaign '%s' in namespace '%s', got error: %s", input.Body.BatchName, input.CampaignID, input.Namespace, err))
+	}
+
+	return diags
+}
+
+// Disables all codes in the code batch described by `data`, if any.
+func (r *AccelBytePlatformCampaignResource) disableCodes(ctx context.Context, data AccelBytePlatformCampaignModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	if data.CodeBatch.IsNull() {
+		return diags
+	}
+
+	var codeBatch AccelBytePlatformCampaignCodeBatchModel
+	diags.Append(data.CodeBatch.As(ctx, &codeBatch, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+
+	tflog.Trace(ctx, "Disabling campaign codes via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"campaignId": data.CampaignId,
+		"batchName":  codeBatch.BatchName,
+	})
+
+	input := &campaign.BulkDisableCodesParams{
+		Namespace:  data.Namespace.ValueString(),
+		CampaignID: data.CampaignId.ValueString(),
+		BatchName:  codeBatch.BatchName.ValueStringPointer(),
+	}
+
+	_, err := r.client.BulkDisableCodesShort(input)
+	if err != nil {
+		diags.AddError("Error when disabling campaign codes via AccelByte API", fmt.Sprintf("Unable to disable code batch '%s' for campaign '%s' in namespace '%s', got error: %s", *input.BatchName, input.CampaignID, input.Namespace, err))
+	}
+
+	return diags
+}
+
+// Retrieves all active codes in the code batch described by `data`, and stores them in `data`.
+func (r *AccelBytePlatformCampaignResource) readCodes(ctx context.Context, data *AccelBytePlatformCampaignModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	codes := []*platformclientmodels.CodeInfo{}
+
+	if !data.CodeBatch.IsNull() {
+		var codeBatch AccelBytePlatformCampaignCodeBatchModel
+		diags.Append(data.CodeBatch.As(ctx, &codeBatch, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return diags
+		}
+
+		activeOnly := true
+		limit := int32(platformCampaignCodesPageSize)
+		offset := int32(0)
+		for {
+			input := &campaign.QueryCodesParams{
+				Namespace:  data.Namespace.ValueString(),
+				CampaignID: data.CampaignId.ValueString(),
+				BatchName:  codeBatch.BatchName.ValueStringPointer(),
+				ActiveOnly: &activeOnly,
+				Limit:      &limit,
+				Offset:     &offset,
+			}
+
+			page, err := r.client.QueryCodesShort(input)
+			if err != nil {
+				diags.AddError("Error when reading campaign codes via AccelByte API", fmt.Sprintf("Unable to read code batch '%s' for campaign '%s' in namespace '%s', got error: %s", *input.BatchName, input.CampaignID, input.Namespace, err))
+				return diags
+			}
+
+			codes = append(codes, page.Data...)
+			if len(page.Data) < int(limit) {
+				break
+			}
+			offset += limit
+		}
+	}
+
+	diags.Append(updateFromApiPlatformCampaignCodes(ctx, data, codes)...)
+
+	return diags
+}
+
+func (r *AccelBytePlatformCampaignResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformCampaignModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &campaign.GetCampaignParams{
+		Namespace:  data.Namespace.ValueString(),
+		CampaignID: data.CampaignId.ValueString(),
+	}
+	apiCampaign, err := r.client.GetCampaignShort(input)
+	if err != nil {
+		notFoundError := &campaign.GetCampaignNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading campaign via AccelByte API", fmt.Sprintf("Unable to read campaign '%s' in namespace '%s', got error: %s", input.CampaignID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read campaign from AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"campaignId": data.CampaignId,
+		"campaign":   apiCampaign,
+	})
+
+	resp.Diagnostics.Append(updateFromApiPlatformCampaign(ctx, &data, apiCampaign)...)
+
+	resp.Diagnostics.Append(r.readCodes(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformCampaignResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformCampaignModel
+	var state AccelBytePlatformCampaignModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCampaign, apiCampaignDiags := toApiPlatformCampaignUpdate(ctx, data)
+	resp.Diagnostics.Append(apiCampaignDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating campaign via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"campaignId": data.CampaignId,
+	})
+
+	input := &campaign.UpdateCampaignParams{
+		Namespace:  data.Namespace.ValueString(),
+		CampaignID: data.CampaignId.ValueString(),
+		Body:       apiCampaign,
+	}
+
+	updatedCampaign, err := r.client.UpdateCampaignShort(input)
+	if err != nil {
+		notFoundError := &campaign.UpdateCampaignNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Campaign '%s' does not exist in namespace '%s'", input.CampaignID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating campaign via AccelByte API", fmt.Sprintf("Unable to update campaign '%s' in namespace '%s', got error: %s", input.CampaignID, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformCampaign(ctx, &data, updatedCampaign)...)
+
+	// Replace the code batch if it has changed
+	// The previous batch is disabled first, so that a new batch with the same name only yields the new codes
+	if !data.CodeBatch.Equal(state.CodeBatch) {
+		resp.Diagnostics.Append(r.disableCodes(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(r.generateCodes(ctx, data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readCodes(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformCampaignResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformCampaignModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deactivating campaign via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"campaignId": data.CampaignId,
+	})
+
+	// Campaigns cannot be deleted; mark the campaign as inactive instead, so that its codes can no longer be redeemed
+	apiCampaign, apiCampaignDiags := toApiPlatformCampaignUpdate(ctx, data)
+	resp.Diagnostics.Append(apiCampaignDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	apiCampaign.Status = platformclientmodels.CampaignUpdateStatusINACTIVE
+
+	input := &campaign.UpdateCampaignParams{
+		Namespace:  data.Namespace.ValueString(),
+		CampaignID: data.CampaignId.ValueString(),
+		Body:       apiCampaign,
+	}
+	_, err := r.client.UpdateCampaignShort(input)
+	if err != nil {
+		notFoundError := &campaign.UpdateCampaignNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting campaign via AccelByte API", fmt.Sprintf("Unable to deactivate campaign '%s' in namespace '%s', got error: %s", input.CampaignID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformCampaignResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "campaign_id")
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformCategoryModel is used by AccelBytePlatformCategoryResource.
+type AccelBytePlatformCategoryModel struct {
+	// Populated by user
+	Namespace    types.String `tfsdk:"namespace"`
+	StoreId      types.String `tfsdk:"store_id"`
+	CategoryPath types.String `tfsdk:"category_path"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	LocalizationDisplayNames types.Map `tfsdk:"localization_display_names"` // map[string]string
+
+	// Computed during Read() operation
+	ParentCategoryPath types.String `tfsdk:"parent_category_path"`
+	Root               types.Bool   `tfsdk:"root"`
+}
+
+// Used by Create, Read and Update operations on store categories.
+// This copies data from the AccelByte API `category` to the TF state `data`.
+func updateFromApiPlatformCategory(ctx context.Context, data *AccelBytePlatformCategoryModel, category *platformclientmodels.FullCategoryInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*category.Namespace)
+	data.CategoryPath = types.StringValue(*category.CategoryPath)
+	data.Id = types.StringValue(computePlatformCategoryId(*category.Namespace, data.StoreId.ValueString(), *category.CategoryPath))
+
+	localizationDisplayNames, localizationDisplayNamesDiags := mapValueFromEvenIfNil(ctx, types.StringType, category.LocalizationDisplayNames)
+	data.LocalizationDisplayNames = localizationDisplayNames
+	diags.Append(localizationDisplayNamesDiags...)
+
+	data.ParentCategoryPath = types.StringPointerValue(category.ParentCategoryPath)
+	data.Root = types.BoolValue(category.Root)
+
+	return diags
+}
+
+// Used by Create/Update operations on store categories.
+// This reads the localized display names from the TF state `data`.
+func toApiPlatformCategoryLocalizationDisplayNames(ctx context.Context, data AccelBytePlatformCategoryModel) (map[string]string, diag.Diagnostics) {
+	localizationDisplayNames := map[string]string{}
+	diags := data.LocalizationDisplayNames.ElementsAs(ctx, &localizationDisplayNames, false)
+	return localizationDisplayNames, diags
+}
+
+// The category path begins with a slash, so it is appended to the rest of the ID without a separator.
+func computePlatformCategoryId(namespace string, storeId string, categoryPath string) string {
+	return fmt.Sprintf("%s/%s%s", namespace, storeId, categoryPath)
+}
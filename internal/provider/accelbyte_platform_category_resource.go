@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/category"
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformCategoryResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformCategoryResource{}
+
+func NewAccelBytePlatformCategoryResource() resource.Resource {
+	return &AccelBytePlatformCategoryResource{}
+}
+
+// AccelBytePlatformCategoryResource defines the resource implementation.
+type AccelBytePlatformCategoryResource struct {
+	client *platform.CategoryService
+}
+
+func (r *AccelBytePlatformCategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_category"
+}
+
+func (r *AccelBytePlatformCategoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [store category](https://docs.accelbyte.io/gaming-services/services/monetization/store/). Categories form a hierarchy within a store: the category `/games/rpg` is a child of the category `/games`. A parent category must be created before its children.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the store. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the store which contains the category. Normally this is the draft store.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"category_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the category, for example `/games/rpg`. The path starts with `/`, and each segment consists of letters and digits. Min 2, max 255 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 255),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^(/[A-Za-z0-9]+)+$`), "must start with a slash, and consist of slash-separated segments of letters and digits"),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Category identifier, on the format `{{namespace}}/{{store_id}}{{category_path}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"localization_display_names": schema.MapAttribute{
+				MarkdownDescription: "Display name of the category, per language. Keys are language codes, for example `en`. Max 255 characters in length.",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 255)),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"parent_category_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the parent category. For top-level categories, this is `/`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"root": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, this is a top-level category.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformCategoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformCategoryService
+}
+
+func (r *AccelBytePlatformCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformCategoryModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localizationDisplayNames, localizationDisplayNamesDiags := toApiPlatformCategoryLocalizationDisplayNames(ctx, data)
+	resp.Diagnostics.Append(localizationDisplayNamesDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating store category via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"storeId":      data.StoreId,
+		"categoryPath": data.CategoryPath,
+	})
+
+	input := &category.CreateCategoryParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueString(),
+		Body: &platformclientmodels.CategoryCreate{
+			CategoryPath:             data.CategoryPath.ValueStringPointer(),
+			LocalizationDisplayNames: localizationDisplayNames,
+		},
+	}
+
+	createdCategory, err := r.client.CreateCategoryShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating store category via AccelByte API", fmt.Sprintf("Unable to create category '%s' in store '%s' in namespace '%s', got error: %s", data.CategoryPath.ValueString(), input.StoreID, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformCategory(ctx, &data, createdCategory)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformCategoryModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &category.GetCategoryParams{
+		Namespace:    data.Namespace.ValueString(),
+		StoreID:      data.StoreId.ValueStringPointer(),
+		CategoryPath: data.CategoryPath.ValueString(),
+	}
+	apiCategory, err := r.client.GetCategoryShort(input)
+	if err != nil {
+		notFoundError := &category.GetCategoryNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading store category via AccelByte API", fmt.Sprintf("Unable to read category '%s' in store '%s' in namespace '%s', got error: %s", input.CategoryPath, data.StoreId.ValueString(), input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read store category from AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"storeId":      data.StoreId,
+		"categoryPath": data.CategoryPath,
+		"category":     apiCategory,
+	})
+
+	resp.Diagnostics.Append(updateFromApiPlatformCategory(ctx, &data, apiCategory)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformCategoryModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	localizationDisplayNames, localizationDisplayNamesDiags := toApiPlatformCategoryLocalizationDisplayNames(ctx, data)
+	resp.Diagnostics.Append(localizationDisplayNamesDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating store category via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"storeId":      data.StoreId,
+		"categoryPath": data.CategoryPath,
+	})
+
+	input := &category.UpdateCategoryParams{
+		Namespace:    data.Namespace.ValueString(),
+		StoreID:      data.StoreId.ValueString(),
+		CategoryPath: data.CategoryPath.ValueString(),
+		Body: &platformclientmodels.CategoryUpdate{
+			LocalizationDisplayNames: localizationDisplayNames,
+		},
+	}
+
+	updatedCategory, err := r.client.UpdateCategoryShort(input)
+	if err != nil {
+		notFoundError := &category.UpdateCategoryNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Category '%s' does not exist in store '%s' in namespace '%s'", input.CategoryPath, input.StoreID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating store category via AccelByte API", fmt.Sprintf("Unable to update category '%s' in store '%s' in namespace '%s', got error: %s", input.CategoryPath, input.StoreID, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformCategory(ctx, &data, updatedCategory)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformCategoryModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting store category via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"storeId":      data.StoreId,
+		"categoryPath": data.CategoryPath,
+	})
+
+	input := &category.DeleteCategoryParams{
+		Namespace:    data.Namespace.ValueString(),
+		StoreID:      data.StoreId.ValueString(),
+		CategoryPath: data.CategoryPath.ValueString(),
+	}
+	_, err := r.client.DeleteCategoryShort(input)
+	if err != nil {
+		notFoundError := &category.DeleteCategoryNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting store category via AccelByte API", fmt.Sprintf("Unable to delete category '%s' in store '%s' in namespace '%s', got error: %s", input.CategoryPath, input.StoreID, input.Namespace, err))
+		return
+	}
+}
+
+// The category path contains slashes, so the generic composite ID parser cannot be used.
+// Everything after the namespace and the store ID is treated as the category path.
+func (r *AccelBytePlatformCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier on the format `{{namespace}}/{{store_id}}{{category_path}}`, got: '%s'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("store_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("category_path"), "/"+parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
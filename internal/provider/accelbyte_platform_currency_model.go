@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformCurrencyModel is used by AccelBytePlatformCurrencyResource.
+type AccelBytePlatformCurrencyModel struct {
+	// Populated by user
+	Namespace    types.String `tfsdk:"namespace"`
+	CurrencyCode types.String `tfsdk:"currency_code"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	CurrencyType types.String `tfsdk:"currency_type"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or the API
+	CurrencySymbol           types.String `tfsdk:"currency_symbol"`
+	Decimals                 types.Int32  `tfsdk:"decimals"`
+	LocalizationDescriptions types.Map    `tfsdk:"localization_descriptions"` // map[string]string
+}
+
+// Used by Create, Read and Update operations on currencies.
+// This copies data from the AccelByte API `currency` to the TF state `data`.
+func updateFromApiPlatformCurrency(ctx context.Context, data *AccelBytePlatformCurrencyModel, currency *platformclientmodels.CurrencyInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*currency.Namespace)
+	data.CurrencyCode = types.StringValue(*currency.CurrencyCode)
+	data.Id = types.StringValue(computePlatformCurrencyId(*currency.Namespace, *currency.CurrencyCode))
+
+	data.CurrencyType = types.StringPointerValue(currency.CurrencyType)
+	data.CurrencySymbol = types.StringPointerValue(currency.CurrencySymbol)
+	data.Decimals = types.Int32PointerValue(currency.Decimals)
+
+	// Descriptions are removed by setting them to an empty string; the API may keep these around, so skip them
+	apiLocalizationDescriptions := map[string]string{}
+	for language, description := range currency.LocalizationDescriptions {
+		if description != "" {
+			apiLocalizationDescriptions[language] = description
+		}
+	}
+	localizationDescriptions, localizationDescriptionsDiags := mapValueFromEvenIfNil(ctx, types.StringType, apiLocalizationDescriptions)
+	data.LocalizationDescriptions = localizationDescriptions
+	diags.Append(localizationDescriptionsDiags...)
+
+	return diags
+}
+
+// Used by the Create operation on currencies.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformCurrencyCreate(ctx context.Context, data AccelBytePlatformCurrencyModel) (*platformclientmodels.CurrencyCreate, diag.Diagnostics) {
+
+	localizationDescriptions := map[string]string{}
+	diags := data.LocalizationDescriptions.ElementsAs(ctx, &localizationDescriptions, false)
+
+	return &platformclientmodels.CurrencyCreate{
+		CurrencyCode:             data.CurrencyCode.ValueStringPointer(),
+		CurrencyType:             data.CurrencyType.ValueString(),
+		CurrencySymbol:           data.CurrencySymbol.ValueString(),
+		Decimals:                 data.Decimals.ValueInt32(),
+		LocalizationDescriptions: localizationDescriptions,
+	}, diags
+}
+
+// Used by the Update operation on currencies.
+// Only the descriptions of a currency can be changed after it has been created.
+// The API ignores an empty set of descriptions, so descriptions that are in the prior TF state `state` but not in `data` are sent as empty strings.
+func toApiPlatformCurrencyUpdate(ctx context.Context, data AccelBytePlatformCurrencyModel, state AccelBytePlatformCurrencyModel) (*platformclientmodels.CurrencyUpdate, diag.Diagnostics) {
+
+	localizationDescriptions := map[string]string{}
+	diags := data.LocalizationDescriptions.ElementsAs(ctx, &localizationDescriptions, false)
+
+	previousLocalizationDescriptions := map[string]string{}
+	diags.Append(state.LocalizationDescriptions.ElementsAs(ctx, &previousLocalizationDescriptions, false)...)
+	for language := range previousLocalizationDescriptions {
+		if _, ok := localizationDescriptions[language]; !ok {
+			localizationDescriptions[language] = ""
+		}
+	}
+
+	return &platformclientmodels.CurrencyUpdate{
+		LocalizationDescriptions: localizationDescriptions,
+	}, diags
+}
+
+func computePlatformCurrencyId(namespace string, currencyCode string) string {
+	return fmt.Sprintf("%s/%s", namespace, currencyCode)
+}
@@ -0,0 +1,339 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/currency"
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformCurrencyResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformCurrencyResource{}
+
+func NewAccelBytePlatformCurrencyResource() resource.Resource {
+	return &AccelBytePlatformCurrencyResource{}
+}
+
+// AccelBytePlatformCurrencyResource defines the resource implementation.
+type AccelBytePlatformCurrencyResource struct {
+	client *platform.CurrencyService
+}
+
+func (r *AccelBytePlatformCurrencyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_currency"
+}
+
+func (r *AccelBytePlatformCurrencyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [currency](https://docs.accelbyte.io/gaming-services/services/monetization/currencies/). Real currencies are used to sell items for real money; virtual currencies are granted by `COINS` items and spent in-game. A currency must exist before any item refers to it.\n\nOnly the descriptions of a currency can be changed in-place. Changing any other attribute forces the currency to be re-created.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the currency. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"currency_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the currency, for example `USD` or `GOLD`. Uppercase characters, digits, `_` and `-`; must start with an uppercase character and end with an uppercase character or digit. Changing this forces the currency to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z]([A-Z0-9_\-]*[A-Z0-9])?$`), "must consist of uppercase characters, digits, '_' or '-', start with an uppercase character and end with an uppercase character or digit"),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Currency identifier, on the format `{{namespace}}/{{currency_code}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"currency_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the currency. Allowed values: `REAL`, `VIRTUAL`. Changing this forces the currency to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(platformclientmodels.CurrencyCreateCurrencyTypeREAL, platformclientmodels.CurrencyCreateCurrencyTypeVIRTUAL),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema or from the API
+
+			"currency_symbol": schema.StringAttribute{
+				MarkdownDescription: "Symbol of the currency, for example `$`. Max 8 characters in length. Changing this forces the currency to be re-created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 8),
+				},
+			},
+			"decimals": schema.Int32Attribute{
+				MarkdownDescription: "Number of decimals of the currency. Commonly `2` for real currencies and `0` for virtual currencies. Changing this forces the currency to be re-created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(0),
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"localization_descriptions": schema.MapAttribute{
+				MarkdownDescription: "Description of the currency, per language. Keys are language codes, for example `en`. Min 1, max 255 characters in length.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(basetypes.NewMapValueMust(types.StringType, map[string]attr.Value{})),
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 255)),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformCurrencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformCurrencyService
+}
+
+func (r *AccelBytePlatformCurrencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformCurrencyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCurrency, apiCurrencyDiags := toApiPlatformCurrencyCreate(ctx, data)
+	resp.Diagnostics.Append(apiCurrencyDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating currency via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"currencyCode": data.CurrencyCode,
+	})
+
+	input := &currency.CreateCurrencyParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiCurrency,
+	}
+
+	createdCurrency, err := r.client.CreateCurrencyShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating currency via AccelByte API", fmt.Sprintf("Unable to create currency '%s' in namespace '%s', got error: %s", data.CurrencyCode.ValueString(), input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformCurrency(ctx, &data, createdCurrency)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// The Platform service has no API for retrieving the full details of a single currency.
+// Instead, all currencies in the namespace are listed, and the matching one is picked out.
+// Returns nil if the currency does not exist.
+func (r *AccelBytePlatformCurrencyResource) read(namespace string, currencyCode string) (*platformclientmodels.CurrencyInfo, error) {
+	currencies, err := r.client.ListCurrenciesShort(&currency.ListCurrenciesParams{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, currency := range currencies {
+		if currency.CurrencyCode != nil && *currency.CurrencyCode == currencyCode {
+			return currency, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *AccelBytePlatformCurrencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformCurrencyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCurrency, err := r.read(data.Namespace.ValueString(), data.CurrencyCode.ValueString())
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading currency via AccelByte API", fmt.Sprintf("Unable to read currency '%s' in namespace '%s', got error: %s", data.CurrencyCode.ValueString(), data.Namespace.ValueString(), err))
+		return
+	}
+
+	if apiCurrency == nil {
+		// The resource does not exist in the AccelByte backend
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read currency from AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"currencyCode": data.CurrencyCode,
+		"currency":     apiCurrency,
+	})
+
+	resp.Diagnostics.Append(updateFromApiPlatformCurrency(ctx, &data, apiCurrency)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformCurrencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformCurrencyModel
+	var state AccelBytePlatformCurrencyModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCurrency, apiCurrencyDiags := toApiPlatformCurrencyUpdate(ctx, data, state)
+	resp.Diagnostics.Append(apiCurrencyDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating currency via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"currencyCode": data.CurrencyCode,
+	})
+
+	input := &currency.UpdateCurrencyParams{
+		Namespace:    data.Namespace.ValueString(),
+		CurrencyCode: data.CurrencyCode.ValueString(),
+		Body:         apiCurrency,
+	}
+
+	updatedCurrency, err := r.client.UpdateCurrencyShort(input)
+	if err != nil {
+		notFoundError := &currency.UpdateCurrencyNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Currency '%s' does not exist in namespace '%s'", input.CurrencyCode, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating currency via AccelByte API", fmt.Sprintf("Unable to update currency '%s' in namespace '%s', got error: %s", input.CurrencyCode, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformCurrency(ctx, &data, updatedCurrency)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformCurrencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformCurrencyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting currency via AccelByte API", map[string]interface{}{
+		"namespace":    data.Namespace,
+		"currencyCode": data.CurrencyCode,
+	})
+
+	input := &currency.DeleteCurrencyParams{
+		Namespace:    data.Namespace.ValueString(),
+		CurrencyCode: data.CurrencyCode.ValueString(),
+	}
+	_, err := r.client.DeleteCurrencyShort(input)
+	if err != nil {
+		notFoundError := &currency.DeleteCurrencyNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting currency via AccelByte API", fmt.Sprintf("Unable to delete currency '%s' in namespace '%s', got error: %s", input.CurrencyCode, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformCurrencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "currency_code")
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformIapConfigAppleModel is used by AccelBytePlatformIapConfigAppleResource.
+type AccelBytePlatformIapConfigAppleModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	BundleId types.String `tfsdk:"bundle_id"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or the API
+	Version    types.String `tfsdk:"version"`
+	AppAppleId types.Int64  `tfsdk:"app_apple_id"`
+	IssuerId   types.String `tfsdk:"issuer_id"`
+	KeyId      types.String `tfsdk:"key_id"`
+
+	// Can be set by user during resource creation; never read back from the AccelByte backend
+	SharedSecret types.String `tfsdk:"shared_secret"`
+	AuthKey      types.String `tfsdk:"auth_key"`
+
+	// Computed during Read() operation
+	AuthKeyFileName types.String `tfsdk:"auth_key_file_name"`
+}
+
+// Used by Create, Read and Update operations on Apple IAP configurations.
+// This copies data from the AccelByte API `appleConfig` to the TF state `data`.
+// The shared secret and auth key are not copied; the TF state keeps the values that were last written.
+func updateFromApiPlatformIapConfigApple(data *AccelBytePlatformIapConfigAppleModel, appleConfig *platformclientmodels.AppleIAPConfigInfo) {
+	data.Namespace = types.StringValue(*appleConfig.Namespace)
+	data.Id = types.StringValue(*appleConfig.Namespace)
+
+	data.BundleId = types.StringValue(appleConfig.BundleID)
+	data.Version = types.StringValue(appleConfig.Version)
+	data.AppAppleId = types.Int64Value(appleConfig.AppAppleID)
+	data.IssuerId = types.StringValue(appleConfig.IssuerID)
+	data.KeyId = types.StringValue(appleConfig.KeyID)
+	data.AuthKeyFileName = types.StringValue(appleConfig.AuthKeyFileName)
+}
+
+// Used by Create and Update operations on Apple IAP configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// The auth key is uploaded separately.
+func toApiPlatformIapConfigApple(data AccelBytePlatformIapConfigAppleModel) *platformclientmodels.AppleIAPConfigRequest {
+	return &platformclientmodels.AppleIAPConfigRequest{
+		BundleID:   data.BundleId.ValueStringPointer(),
+		Version:    data.Version.ValueString(),
+		AppAppleID: data.AppAppleId.ValueInt64(),
+		IssuerID:   data.IssuerId.ValueString(),
+		KeyID:      data.KeyId.ValueString(),
+		Password:   data.SharedSecret.ValueString(),
+	}
+}
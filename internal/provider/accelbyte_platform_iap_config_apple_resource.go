@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/iap"
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformIapConfigAppleResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformIapConfigAppleResource{}
+
+func NewAccelBytePlatformIapConfigAppleResource() resource.Resource {
+	return &AccelBytePlatformIapConfigAppleResource{}
+}
+
+// AccelBytePlatformIapConfigAppleResource defines the resource implementation.
+type AccelBytePlatformIapConfigAppleResource struct {
+	client *platform.IAPService
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_iap_config_apple"
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the [Apple in-app purchase configuration](https://docs.accelbyte.io/gaming-services/services/monetization/) of a namespace. It allows the Platform service to verify App Store receipts and grant entitlements for them. There is at most one Apple IAP configuration per namespace.\n\n`shared_secret` and `auth_key` are marked as sensitive, but they are stored in the Terraform state; the plugin framework version used by this provider does not support write-only attributes.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the Apple IAP configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Apple IAP configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"bundle_id": schema.StringAttribute{
+				MarkdownDescription: "Bundle ID of the app, for example `com.example.mygame`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema or from the API
+
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of Apple's in-app purchase API to use. `V1` does not support subscriptions. `V2` requires `app_apple_id`, `issuer_id`, `key_id` and `auth_key`. Allowed values: `V1`, `V2`. If not set, the Platform service's default is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(platformclientmodels.AppleIAPConfigRequestVersionV1, platformclientmodels.AppleIAPConfigRequestVersionV2),
+				},
+			},
+			"app_apple_id": schema.Int64Attribute{
+				MarkdownDescription: "Apple ID of the app, as shown in App Store Connect. `0` means not set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"issuer_id": schema.StringAttribute{
+				MarkdownDescription: "Issuer ID of the App Store Connect API key.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: "Key ID of the App Store Connect API key.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+
+			// Can be set by user during resource creation; never read back from the AccelByte backend
+
+			"shared_secret": schema.StringAttribute{
+				MarkdownDescription: "App-specific shared secret, used to verify receipts. The AccelByte API does not reliably return secrets, so this value is never read back; changes made outside of Terraform will not be detected. If not set, any existing shared secret is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"auth_key": schema.StringAttribute{
+				MarkdownDescription: "Contents of the App Store Connect API private key (`.p8` file), for example `file(\"AuthKey_ABC123.p8\")`. It is uploaded whenever it changes. This value is never read back; changes made outside of Terraform will not be detected. If not set, any existing key is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"auth_key_file_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the uploaded private key file. Empty if no key has been uploaded.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformIAPService
+}
+
+// Writes the configuration in `data` to the AccelByte backend, and uploads the auth key if it differs from `previousAuthKey`.
+// Used by both Create and Update, since the API creates the configuration if it does not exist yet.
+func (r *AccelBytePlatformIapConfigAppleResource) write(ctx context.Context, data *AccelBytePlatformIapConfigAppleModel, previousAuthKey types.String) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	input := &iap.UpdateAppleIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigApple(*data),
+	}
+
+	appleConfig, err := r.client.UpdateAppleIAPConfigShort(input)
+	if err != nil {
+		diags.AddError("Error when writing Apple IAP configuration via AccelByte API", fmt.Sprintf("Unable to write Apple IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return diags
+	}
+
+	if !data.AuthKey.IsNull() && !data.AuthKey.Equal(previousAuthKey) {
+		tflog.Trace(ctx, "Uploading Apple IAP auth key via AccelByte API", map[string]interface{}{
+			"namespace": data.Namespace,
+		})
+
+		fileName := "AuthKey.p8"
+		if data.KeyId.ValueString() != "" {
+			fileName = fmt.Sprintf("AuthKey_%s.p8", data.KeyId.ValueString())
+		}
+
+		fileInput := &iap.UpdateAppleP8FileParams{
+			Namespace: data.Namespace.ValueString(),
+			File:      runtime.NamedReader(fileName, strings.NewReader(data.AuthKey.ValueString())),
+		}
+
+		appleConfig, err = r.client.UpdateAppleP8FileShort(fileInput)
+		if err != nil {
+			diags.AddError("Error when uploading Apple IAP auth key via AccelByte API", fmt.Sprintf("Unable to upload auth key for Apple IAP configuration in namespace '%s', got error: %s", fileInput.Namespace, err))
+			return diags
+		}
+	}
+
+	updateFromApiPlatformIapConfigApple(data, appleConfig)
+
+	return diags
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformIapConfigAppleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating Apple IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data, types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformIapConfigAppleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &iap.GetAppleIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	appleConfig, err := r.client.GetAppleIAPConfigShort(input)
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading Apple IAP configuration via AccelByte API", fmt.Sprintf("Unable to read Apple IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	if appleConfig.BundleID == "" {
+		// The API returns an empty configuration when none has been set up
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read Apple IAP configuration from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"bundleId":  appleConfig.BundleID,
+	})
+
+	updateFromApiPlatformIapConfigApple(&data, appleConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformIapConfigAppleModel
+	var state AccelBytePlatformIapConfigAppleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating Apple IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data, state.AuthKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformIapConfigAppleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting Apple IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.DeleteAppleIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	err := r.client.DeleteAppleIAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting Apple IAP configuration via AccelByte API", fmt.Sprintf("Unable to delete Apple IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformIapConfigAppleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformIapConfigEpicGamesModel is used by AccelBytePlatformIapConfigEpicGamesResource.
+type AccelBytePlatformIapConfigEpicGamesModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	SandboxId types.String `tfsdk:"sandbox_id"`
+}
+
+// Used by Create, Read and Update operations on Epic Games IAP configurations.
+// This copies data from the AccelByte API `epicGamesConfig` to the TF state `data`.
+func updateFromApiPlatformIapConfigEpicGames(data *AccelBytePlatformIapConfigEpicGamesModel, epicGamesConfig *platformclientmodels.EpicGamesIAPConfigInfo) {
+	data.Namespace = types.StringValue(*epicGamesConfig.Namespace)
+	data.Id = types.StringValue(*epicGamesConfig.Namespace)
+
+	data.SandboxId = types.StringValue(epicGamesConfig.SandboxID)
+}
+
+// Used by Create and Update operations on Epic Games IAP configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformIapConfigEpicGames(data AccelBytePlatformIapConfigEpicGamesModel) *platformclientmodels.EpicGamesIAPConfigRequest {
+	return &platformclientmodels.EpicGamesIAPConfigRequest{
+		SandboxID: data.SandboxId.ValueStringPointer(),
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/iap"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformIapConfigEpicGamesResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformIapConfigEpicGamesResource{}
+
+func NewAccelBytePlatformIapConfigEpicGamesResource() resource.Resource {
+	return &AccelBytePlatformIapConfigEpicGamesResource{}
+}
+
+// AccelBytePlatformIapConfigEpicGamesResource defines the resource implementation.
+type AccelBytePlatformIapConfigEpicGamesResource struct {
+	client *platform.IAPService
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_iap_config_epic_games"
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the [Epic Games in-app purchase configuration](https://docs.accelbyte.io/gaming-services/services/monetization/) of a namespace. It allows the Platform service to sync Epic Games Store purchases into entitlements. There is at most one Epic Games IAP configuration per namespace.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the Epic Games IAP configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Epic Games IAP configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"sandbox_id": schema.StringAttribute{
+				MarkdownDescription: "Epic Games sandbox ID of the game.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformIAPService
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformIapConfigEpicGamesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating Epic Games IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.UpdateEpicGamesIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigEpicGames(data),
+	}
+
+	epicGamesConfig, err := r.client.UpdateEpicGamesIAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating Epic Games IAP configuration via AccelByte API", fmt.Sprintf("Unable to create Epic Games IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	updateFromApiPlatformIapConfigEpicGames(&data, epicGamesConfig)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformIapConfigEpicGamesModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &iap.GetEpicGamesIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	epicGamesConfig, err := r.client.GetEpicGamesIAPConfigShort(input)
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading Epic Games IAP configuration via AccelByte API", fmt.Sprintf("Unable to read Epic Games IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	if epicGamesConfig.SandboxID == "" {
+		// The API returns an empty configuration when none has been set up
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read Epic Games IAP configuration from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"sandboxId": epicGamesConfig.SandboxID,
+	})
+
+	updateFromApiPlatformIapConfigEpicGames(&data, epicGamesConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformIapConfigEpicGamesModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating Epic Games IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.UpdateEpicGamesIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigEpicGames(data),
+	}
+
+	epicGamesConfig, err := r.client.UpdateEpicGamesIAPConfigShort(input)
+	if err != nil {
+		// Failed to update the resource in the AccelByte backend
+		// The backend refused our update operation; we should abort
+		resp.Diagnostics.AddError("Error when updating Epic Games IAP configuration via AccelByte API", fmt.Sprintf("Unable to update Epic Games IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	updateFromApiPlatformIapConfigEpicGames(&data, epicGamesConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformIapConfigEpicGamesModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting Epic Games IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.DeleteEpicGamesIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	err := r.client.DeleteEpicGamesIAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting Epic Games IAP configuration via AccelByte API", fmt.Sprintf("Unable to delete Epic Games IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformIapConfigEpicGamesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
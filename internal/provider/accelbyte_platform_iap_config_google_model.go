@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformIapConfigGoogleModel is used by AccelBytePlatformIapConfigGoogleResource.
+type AccelBytePlatformIapConfigGoogleModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	ApplicationName  types.String `tfsdk:"application_name"`
+	PackageName      types.String `tfsdk:"package_name"`
+	ServiceAccountId types.String `tfsdk:"service_account_id"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	NotificationTokenAudience types.String `tfsdk:"notification_token_audience"`
+	NotificationTokenEmail    types.String `tfsdk:"notification_token_email"`
+
+	// Can be set by user during resource creation; never read back from the AccelByte backend
+	ServiceAccountP12Key types.String `tfsdk:"service_account_p12_key"`
+
+	// Computed during Read() operation
+	P12FileName types.String `tfsdk:"p12_file_name"`
+}
+
+// Used by Create, Read and Update operations on Google IAP configurations.
+// This copies data from the AccelByte API `googleConfig` to the TF state `data`.
+// The service account key is not copied; the TF state keeps the value that was last written.
+func updateFromApiPlatformIapConfigGoogle(data *AccelBytePlatformIapConfigGoogleModel, googleConfig *platformclientmodels.GoogleIAPConfigInfo) {
+	data.Namespace = types.StringValue(*googleConfig.Namespace)
+	data.Id = types.StringValue(*googleConfig.Namespace)
+
+	data.ApplicationName = types.StringValue(googleConfig.ApplicationName)
+	data.PackageName = types.StringValue(googleConfig.PackageName)
+	data.ServiceAccountId = types.StringValue(googleConfig.ServiceAccountID)
+	data.NotificationTokenAudience = types.StringValue(googleConfig.NotificationTokenAudience)
+	data.NotificationTokenEmail = types.StringValue(googleConfig.NotificationTokenEmail)
+	data.P12FileName = types.StringValue(googleConfig.P12FileName)
+}
+
+// Used by Create and Update operations on Google IAP configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// The service account key is uploaded separately.
+func toApiPlatformIapConfigGoogle(data AccelBytePlatformIapConfigGoogleModel) *platformclientmodels.GoogleIAPConfigRequest {
+	return &platformclientmodels.GoogleIAPConfigRequest{
+		ApplicationName:           data.ApplicationName.ValueStringPointer(),
+		PackageName:               data.PackageName.ValueStringPointer(),
+		ServiceAccountID:          data.ServiceAccountId.ValueStringPointer(),
+		NotificationTokenAudience: data.NotificationTokenAudience.ValueString(),
+		NotificationTokenEmail:    data.NotificationTokenEmail.ValueString(),
+	}
+}
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/iap"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformIapConfigGoogleResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformIapConfigGoogleResource{}
+var _ resource.ResourceWithValidateConfig = &AccelBytePlatformIapConfigGoogleResource{}
+var _ resource.ResourceWithModifyPlan = &AccelBytePlatformIapConfigGoogleResource{}
+
+func NewAccelBytePlatformIapConfigGoogleResource() resource.Resource {
+	return &AccelBytePlatformIapConfigGoogleResource{}
+}
+
+// AccelBytePlatformIapConfigGoogleResource defines the resource implementation.
+type AccelBytePlatformIapConfigGoogleResource struct {
+	client *platform.IAPService
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_iap_config_google"
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the [Google in-app purchase configuration](https://docs.accelbyte.io/gaming-services/services/monetization/) of a namespace. It allows the Platform service to verify Google Play purchases and grant entitlements for them. There is at most one Google IAP configuration per namespace.\n\n`service_account_p12_key` is marked as sensitive, but it is stored in the Terraform state; the plugin framework version used by this provider does not support write-only attributes.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the Google IAP configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Google IAP configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"application_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the application, as registered in the Google Play Console.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "Package name of the app, for example `com.example.mygame`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Google Cloud service account which has access to the Google Play Developer API, for example `iap@mygame.iam.gserviceaccount.com`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"notification_token_audience": schema.StringAttribute{
+				MarkdownDescription: "Expected audience of the JWT in real-time developer notifications. If empty, the audience is not validated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"notification_token_email": schema.StringAttribute{
+				MarkdownDescription: "Expected email of the JWT in real-time developer notifications. If empty, the email is not validated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+
+			// Can be set by user during resource creation; never read back from the AccelByte backend
+
+			"service_account_p12_key": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded contents of the service account's private key (`.p12` file), for example `filebase64(\"service-account.p12\")`. It is uploaded whenever it changes. This value is never read back; changes made outside of Terraform will not be detected. If not set, any existing key is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"p12_file_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the uploaded private key file. Empty if no key has been uploaded.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelBytePlatformIapConfigGoogleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ServiceAccountP12Key.IsUnknown() || data.ServiceAccountP12Key.IsNull() {
+		return
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(data.ServiceAccountP12Key.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("service_account_p12_key"),
+			"Invalid attribute value",
+			fmt.Sprintf("Attribute `service_account_p12_key` must be base64-encoded, got error: %s", err),
+		)
+	}
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The file name is only known in advance when no key is going to be uploaded
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state AccelBytePlatformIapConfigGoogleModel
+	var plan AccelBytePlatformIapConfigGoogleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ServiceAccountP12Key.IsNull() && !plan.ServiceAccountP12Key.Equal(state.ServiceAccountP12Key) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("p12_file_name"), types.StringUnknown())...)
+	}
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformIAPService
+}
+
+// Writes the configuration in `data` to the AccelByte backend, and uploads the service account key if it differs from `previousServiceAccountP12Key`.
+// Used by both Create and Update, since the API creates the configuration if it does not exist yet.
+func (r *AccelBytePlatformIapConfigGoogleResource) write(ctx context.Context, data *AccelBytePlatformIapConfigGoogleModel, previousServiceAccountP12Key types.String) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	// Decode the service account key before writing anything, so that an invalid key does not leave the configuration half-written
+	uploadP12Key := !data.ServiceAccountP12Key.IsNull() && !data.ServiceAccountP12Key.Equal(previousServiceAccountP12Key)
+	var p12Key []byte
+	if uploadP12Key {
+		var err error
+		p12Key, err = base64.StdEncoding.DecodeString(data.ServiceAccountP12Key.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("service_account_p12_key"), "Invalid service account key", fmt.Sprintf("Unable to decode base64-encoded service account key, got error: %s", err))
+			return diags
+		}
+	}
+
+	input := &iap.UpdateGoogleIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigGoogle(*data),
+	}
+
+	googleConfig, err := r.client.UpdateGoogleIAPConfigShort(input)
+	if err != nil {
+		diags.AddError("Error when writing Google IAP configuration via AccelByte API", fmt.Sprintf("Unable to write Google IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return diags
+	}
+
+	if uploadP12Key {
+		tflog.Trace(ctx, "Uploading Google IAP service account key via AccelByte API", map[string]interface{}{
+			"namespace": data.Namespace,
+		})
+
+		fileInput := &iap.UpdateGoogleP12FileParams{
+			Namespace: data.Namespace.ValueString(),
+			File:      runtime.NamedReader("service-account.p12", bytes.NewReader(p12Key)),
+		}
+
+		googleConfig, err = r.client.UpdateGoogleP12FileShort(fileInput)
+		if err != nil {
+			diags.AddError("Error when uploading Google IAP service account key via AccelByte API", fmt.Sprintf("Unable to upload service account key for Google IAP configuration in namespace '%s', got error: %s", fileInput.Namespace, err))
+			return diags
+		}
+	}
+
+	updateFromApiPlatformIapConfigGoogle(data, googleConfig)
+
+	return diags
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformIapConfigGoogleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating Google IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data, types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformIapConfigGoogleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &iap.GetGoogleIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	googleConfig, err := r.client.GetGoogleIAPConfigShort(input)
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading Google IAP configuration via AccelByte API", fmt.Sprintf("Unable to read Google IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	if googleConfig.PackageName == "" {
+		// The API returns an empty configuration when none has been set up
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read Google IAP configuration from AccelByte API", map[string]interface{}{
+		"namespace":   data.Namespace,
+		"packageName": googleConfig.PackageName,
+	})
+
+	updateFromApiPlatformIapConfigGoogle(&data, googleConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformIapConfigGoogleModel
+	var state AccelBytePlatformIapConfigGoogleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating Google IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data, state.ServiceAccountP12Key)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformIapConfigGoogleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting Google IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.DeleteGoogleIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	err := r.client.DeleteGoogleIAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting Google IAP configuration via AccelByte API", fmt.Sprintf("Unable to delete Google IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformIapConfigGoogleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
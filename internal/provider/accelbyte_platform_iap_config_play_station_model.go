@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformIapConfigPlayStationModel is used by AccelBytePlatformIapConfigPlayStationResource.
+type AccelBytePlatformIapConfigPlayStationModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Environment types.String `tfsdk:"environment"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	BackOfficeServerClientId types.String `tfsdk:"back_office_server_client_id"`
+	EnableStreamJob          types.Bool   `tfsdk:"enable_stream_job"`
+	StreamName               types.String `tfsdk:"stream_name"`
+	StreamPartnerName        types.String `tfsdk:"stream_partner_name"`
+
+	// Can be set by user during resource creation; never read back from the AccelByte backend
+	BackOfficeServerClientSecret types.String `tfsdk:"back_office_server_client_secret"`
+}
+
+// Used by Create, Read and Update operations on PlayStation IAP configurations.
+// This copies data from the AccelByte API `playStationConfig` to the TF state `data`.
+// The client secret is not copied; the TF state keeps the value that was last written.
+func updateFromApiPlatformIapConfigPlayStation(data *AccelBytePlatformIapConfigPlayStationModel, playStationConfig *platformclientmodels.PlayStationIAPConfigInfo) {
+	data.Namespace = types.StringValue(*playStationConfig.Namespace)
+	data.Id = types.StringValue(*playStationConfig.Namespace)
+
+	data.Environment = types.StringValue(playStationConfig.Environment)
+	data.BackOfficeServerClientId = types.StringValue(playStationConfig.BackOfficeServerClientID)
+	data.EnableStreamJob = types.BoolValue(playStationConfig.EnableStreamJob)
+	data.StreamName = types.StringValue(playStationConfig.StreamName)
+	data.StreamPartnerName = types.StringValue(playStationConfig.StreamPartnerName)
+}
+
+// Used by Create and Update operations on PlayStation IAP configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformIapConfigPlayStation(data AccelBytePlatformIapConfigPlayStationModel) *platformclientmodels.PlaystationIAPConfigRequest {
+	return &platformclientmodels.PlaystationIAPConfigRequest{
+		Environment:                  data.Environment.ValueStringPointer(),
+		BackOfficeServerClientID:     data.BackOfficeServerClientId.ValueString(),
+		BackOfficeServerClientSecret: data.BackOfficeServerClientSecret.ValueString(),
+		EnableStreamJob:              data.EnableStreamJob.ValueBool(),
+		StreamName:                   data.StreamName.ValueString(),
+		StreamPartnerName:            data.StreamPartnerName.ValueString(),
+	}
+}
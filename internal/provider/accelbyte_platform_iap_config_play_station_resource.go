@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/iap"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformIapConfigPlayStationResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformIapConfigPlayStationResource{}
+
+func NewAccelBytePlatformIapConfigPlayStationResource() resource.Resource {
+	return &AccelBytePlatformIapConfigPlayStationResource{}
+}
+
+// AccelBytePlatformIapConfigPlayStationResource defines the resource implementation.
+type AccelBytePlatformIapConfigPlayStationResource struct {
+	client *platform.IAPService
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_iap_config_play_station"
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the [PlayStation in-app purchase configuration](https://docs.accelbyte.io/gaming-services/services/monetization/) of a namespace. It allows the Platform service to sync PlayStation Store purchases into entitlements. There is at most one PlayStation IAP configuration per namespace.\n\nAfter every create or update, the Platform service tests the connection to PlayStation Network with the stored credentials. A failed test is reported as a warning.\n\n`back_office_server_client_secret` is marked as sensitive, but it is stored in the Terraform state; the plugin framework version used by this provider does not support write-only attributes.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the PlayStation IAP configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "PlayStation IAP configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "PlayStation Network environment, for example `sp-int`, `prod-qa` or `np`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"back_office_server_client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID of the back office server, issued by PlayStation Partners.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"enable_stream_job": schema.BoolAttribute{
+				MarkdownDescription: "Whether to consume the PlayStation Network event stream, which syncs refunds and chargebacks.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"stream_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the PlayStation Network event stream.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"stream_partner_name": schema.StringAttribute{
+				MarkdownDescription: "Partner name of the PlayStation Network event stream.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+
+			// Can be set by user during resource creation; never read back from the AccelByte backend
+
+			"back_office_server_client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the back office server, issued by PlayStation Partners. The AccelByte API does not reliably return secrets, so this value is never read back; changes made outside of Terraform will not be detected. If not set, any existing secret is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformIAPService
+}
+
+// Writes the configuration in `data` to the AccelByte backend, and tests the connection with the stored configuration.
+// Used by both Create and Update, since the API creates the configuration if it does not exist yet.
+func (r *AccelBytePlatformIapConfigPlayStationResource) write(ctx context.Context, data *AccelBytePlatformIapConfigPlayStationModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	input := &iap.UpdatePlaystationIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigPlayStation(*data),
+	}
+
+	playStationConfig, err := r.client.UpdatePlaystationIAPConfigShort(input)
+	if err != nil {
+		diags.AddError("Error when writing PlayStation IAP configuration via AccelByte API", fmt.Sprintf("Unable to write PlayStation IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return diags
+	}
+
+	updateFromApiPlatformIapConfigPlayStation(data, playStationConfig)
+
+	// The configuration has been stored at this point, so a failed connection test does not fail the operation
+	tflog.Trace(ctx, "Testing PlayStation IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	testResult, err := r.client.ValidateExistedPlaystationIAPConfigShort(&iap.ValidateExistedPlaystationIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	})
+	if err != nil {
+		diags.AddWarning("Unable to test PlayStation IAP configuration", fmt.Sprintf("Unable to test the connection to PlayStation Network for namespace '%s', got error: %s", input.Namespace, err))
+	} else if testResult.Success == nil || !*testResult.Success {
+		diags.AddWarning("PlayStation IAP configuration test failed", fmt.Sprintf("The connection to PlayStation Network for namespace '%s' could not be established with the stored configuration: %s", input.Namespace, testResult.Msg))
+	}
+
+	return diags
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformIapConfigPlayStationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating PlayStation IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformIapConfigPlayStationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &iap.GetPlayStationIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	playStationConfig, err := r.client.GetPlayStationIAPConfigShort(input)
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading PlayStation IAP configuration via AccelByte API", fmt.Sprintf("Unable to read PlayStation IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	if playStationConfig.Environment == "" {
+		// The API returns an empty configuration when none has been set up
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read PlayStation IAP configuration from AccelByte API", map[string]interface{}{
+		"namespace":   data.Namespace,
+		"environment": playStationConfig.Environment,
+	})
+
+	updateFromApiPlatformIapConfigPlayStation(&data, playStationConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformIapConfigPlayStationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating PlayStation IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformIapConfigPlayStationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting PlayStation IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.DeletePlaystationIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	err := r.client.DeletePlaystationIAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting PlayStation IAP configuration via AccelByte API", fmt.Sprintf("Unable to delete PlayStation IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformIapConfigPlayStationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
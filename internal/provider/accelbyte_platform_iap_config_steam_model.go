@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformIapConfigSteamModel is used by AccelBytePlatformIapConfigSteamResource.
+type AccelBytePlatformIapConfigSteamModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	AppId types.String `tfsdk:"app_id"`
+
+	// Can be set by user during resource creation; never read back from the AccelByte backend
+	PublisherAuthenticationKey types.String `tfsdk:"publisher_authentication_key"`
+}
+
+// Used by Create, Read and Update operations on Steam IAP configurations.
+// This copies data from the AccelByte API to the TF state `data`.
+// The Get and Update APIs return different types, so the caller passes in the individual fields.
+// The publisher authentication key is not copied; the TF state keeps the value that was last written.
+func updateFromApiPlatformIapConfigSteam(data *AccelBytePlatformIapConfigSteamModel, namespace string, appId string) {
+	data.Namespace = types.StringValue(namespace)
+	data.Id = types.StringValue(namespace)
+
+	data.AppId = types.StringValue(appId)
+}
+
+// Used by Create and Update operations on Steam IAP configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformIapConfigSteam(data AccelBytePlatformIapConfigSteamModel) *platformclientmodels.SteamIAPConfigRequest {
+	return &platformclientmodels.SteamIAPConfigRequest{
+		AppID:                      data.AppId.ValueStringPointer(),
+		PublisherAuthenticationKey: data.PublisherAuthenticationKey.ValueString(),
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/iap"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformIapConfigSteamResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformIapConfigSteamResource{}
+
+func NewAccelBytePlatformIapConfigSteamResource() resource.Resource {
+	return &AccelBytePlatformIapConfigSteamResource{}
+}
+
+// AccelBytePlatformIapConfigSteamResource defines the resource implementation.
+type AccelBytePlatformIapConfigSteamResource struct {
+	client *platform.IAPService
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_iap_config_steam"
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the [Steam in-app purchase configuration](https://docs.accelbyte.io/gaming-services/services/monetization/) of a namespace. It allows the Platform service to sync Steam purchases into entitlements. There is at most one Steam IAP configuration per namespace.\n\n`publisher_authentication_key` is marked as sensitive, but it is stored in the Terraform state; the plugin framework version used by this provider does not support write-only attributes.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the Steam IAP configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Steam IAP configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "Steam App ID of the game.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; never read back from the AccelByte backend
+
+			"publisher_authentication_key": schema.StringAttribute{
+				MarkdownDescription: "Steam Web API publisher authentication key. The AccelByte API does not reliably return secrets, so this value is never read back; changes made outside of Terraform will not be detected. If not set, any existing key is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformIAPService
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformIapConfigSteamModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating Steam IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.UpdateSteamIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigSteam(data),
+	}
+
+	steamConfig, err := r.client.UpdateSteamIAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating Steam IAP configuration via AccelByte API", fmt.Sprintf("Unable to create Steam IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	updateFromApiPlatformIapConfigSteam(&data, *steamConfig.Namespace, steamConfig.AppID)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformIapConfigSteamModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &iap.GetSteamIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	steamConfig, err := r.client.GetSteamIAPConfigShort(input)
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading Steam IAP configuration via AccelByte API", fmt.Sprintf("Unable to read Steam IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	if steamConfig.AppID == "" {
+		// The API returns an empty configuration when none has been set up
+		// Ensure that it does not exist in the Terraform state either
+		// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Trace(ctx, "Read Steam IAP configuration from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"appId":     steamConfig.AppID,
+	})
+
+	updateFromApiPlatformIapConfigSteam(&data, input.Namespace, steamConfig.AppID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformIapConfigSteamModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating Steam IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.UpdateSteamIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigSteam(data),
+	}
+
+	steamConfig, err := r.client.UpdateSteamIAPConfigShort(input)
+	if err != nil {
+		// Failed to update the resource in the AccelByte backend
+		// The backend refused our update operation; we should abort
+		resp.Diagnostics.AddError("Error when updating Steam IAP configuration via AccelByte API", fmt.Sprintf("Unable to update Steam IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	updateFromApiPlatformIapConfigSteam(&data, *steamConfig.Namespace, steamConfig.AppID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformIapConfigSteamModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting Steam IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.DeleteSteamIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	err := r.client.DeleteSteamIAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting Steam IAP configuration via AccelByte API", fmt.Sprintf("Unable to delete Steam IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformIapConfigSteamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
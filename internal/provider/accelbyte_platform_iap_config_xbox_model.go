@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformIapConfigXboxModel is used by AccelBytePlatformIapConfigXboxResource.
+type AccelBytePlatformIapConfigXboxModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	EnableClawback   types.Bool   `tfsdk:"enable_clawback"`
+	EntraTenantId    types.String `tfsdk:"entra_tenant_id"`
+	EntraAppClientId types.String `tfsdk:"entra_app_client_id"`
+
+	// Can be set by user during resource creation; never read back from the AccelByte backend
+	RelyingPartyCert            types.String `tfsdk:"relying_party_cert"`
+	EntraAppClientSecret        types.String `tfsdk:"entra_app_client_secret"`
+	BusinessPartnerCertP12      types.String `tfsdk:"business_partner_cert_p12"`
+	BusinessPartnerCertPassword types.String `tfsdk:"business_partner_cert_password"`
+
+	// Computed during Read() operation
+	BusinessPartnerCertFileName    types.String `tfsdk:"business_partner_cert_file_name"`
+	BusinessPartnerCertExpiredTime types.String `tfsdk:"business_partner_cert_expired_time"`
+}
+
+// Used by Create, Read and Update operations on Xbox IAP configurations.
+// This copies data from the AccelByte API `xboxConfig` to the TF state `data`.
+// Certificates, secrets and passwords are not copied; the TF state keeps the values that were last written.
+func updateFromApiPlatformIapConfigXbox(data *AccelBytePlatformIapConfigXboxModel, xboxConfig *platformclientmodels.XblIAPConfigInfo) {
+	data.Namespace = types.StringValue(*xboxConfig.Namespace)
+	data.Id = types.StringValue(*xboxConfig.Namespace)
+
+	data.EnableClawback = types.BoolValue(xboxConfig.EnableClawback)
+	data.EntraTenantId = types.StringValue(xboxConfig.EntraTenantID)
+	data.EntraAppClientId = types.StringValue(xboxConfig.EntraAppClientID)
+	data.BusinessPartnerCertFileName = types.StringValue(xboxConfig.BusinessPartnerCertFileName)
+	data.BusinessPartnerCertExpiredTime = fromApiPlatformItemTimestamp(xboxConfig.BusinessPartnerCertExpiredTime)
+}
+
+// Used by Create and Update operations on Xbox IAP configurations.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// The business partner certificate is uploaded separately.
+func toApiPlatformIapConfigXbox(data AccelBytePlatformIapConfigXboxModel) *platformclientmodels.XblIAPConfigRequest {
+	return &platformclientmodels.XblIAPConfigRequest{
+		EnableClawback:       data.EnableClawback.ValueBool(),
+		EntraTenantID:        data.EntraTenantId.ValueString(),
+		EntraAppClientID:     data.EntraAppClientId.ValueString(),
+		EntraAppClientSecret: data.EntraAppClientSecret.ValueString(),
+		RelyingPartyCert:     data.RelyingPartyCert.ValueString(),
+	}
+}
@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/iap"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/go-openapi/runtime"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformIapConfigXboxResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformIapConfigXboxResource{}
+var _ resource.ResourceWithValidateConfig = &AccelBytePlatformIapConfigXboxResource{}
+var _ resource.ResourceWithModifyPlan = &AccelBytePlatformIapConfigXboxResource{}
+
+func NewAccelBytePlatformIapConfigXboxResource() resource.Resource {
+	return &AccelBytePlatformIapConfigXboxResource{}
+}
+
+// AccelBytePlatformIapConfigXboxResource defines the resource implementation.
+type AccelBytePlatformIapConfigXboxResource struct {
+	client *platform.IAPService
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_iap_config_xbox"
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the [Xbox in-app purchase configuration](https://docs.accelbyte.io/gaming-services/services/monetization/) of a namespace. It allows the Platform service to sync Microsoft Store purchases into entitlements. There is at most one Xbox IAP configuration per namespace.\n\n`relying_party_cert`, `entra_app_client_secret`, `business_partner_cert_p12` and `business_partner_cert_password` are marked as sensitive, but they are stored in the Terraform state; the plugin framework version used by this provider does not support write-only attributes.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the Xbox IAP configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Xbox IAP configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"enable_clawback": schema.BoolAttribute{
+				MarkdownDescription: "Whether to consume the Xbox clawback event stream, which syncs refunds and chargebacks.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"entra_tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Tenant ID of the Microsoft Entra application which is used to access the Microsoft Store APIs.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"entra_app_client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID of the Microsoft Entra application which is used to access the Microsoft Store APIs.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+
+			// Can be set by user during resource creation; never read back from the AccelByte backend
+
+			"relying_party_cert": schema.StringAttribute{
+				MarkdownDescription: "Relying party certificate, used to validate Xbox Live tokens. Deprecated by the Platform service in favour of the Microsoft Entra application. The AccelByte API does not reliably return secrets, so this value is never read back; changes made outside of Terraform will not be detected. If not set, any existing certificate is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"entra_app_client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the Microsoft Entra application. This value is never read back; changes made outside of Terraform will not be detected. If not set, any existing secret is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"business_partner_cert_p12": schema.StringAttribute{
+				MarkdownDescription: "Base64-encoded contents of the business partner certificate (`.p12` file), for example `filebase64(\"business-partner.p12\")`. It is uploaded whenever it or `business_partner_cert_password` changes. This value is never read back; changes made outside of Terraform will not be detected. If not set, any existing certificate is left unchanged.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"business_partner_cert_password": schema.StringAttribute{
+				MarkdownDescription: "Password of the business partner certificate. This value is never read back.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("business_partner_cert_p12")),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"business_partner_cert_file_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the uploaded business partner certificate file. Empty if no certificate has been uploaded.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"business_partner_cert_expired_time": schema.StringAttribute{
+				MarkdownDescription: "Time at which the uploaded business partner certificate expires. Null if no certificate has been uploaded.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelBytePlatformIapConfigXboxModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.BusinessPartnerCertP12.IsUnknown() || data.BusinessPartnerCertP12.IsNull() {
+		return
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(data.BusinessPartnerCertP12.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("business_partner_cert_p12"),
+			"Invalid attribute value",
+			fmt.Sprintf("Attribute `business_partner_cert_p12` must be base64-encoded, got error: %s", err),
+		)
+	}
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The certificate's file name and expiry are only known in advance when no certificate is going to be uploaded
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state AccelBytePlatformIapConfigXboxModel
+	var plan AccelBytePlatformIapConfigXboxModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certChanged := !plan.BusinessPartnerCertP12.Equal(state.BusinessPartnerCertP12) || !plan.BusinessPartnerCertPassword.Equal(state.BusinessPartnerCertPassword)
+	if !plan.BusinessPartnerCertP12.IsNull() && certChanged {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("business_partner_cert_file_name"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("business_partner_cert_expired_time"), types.StringUnknown())...)
+	}
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformIAPService
+}
+
+// Writes the configuration in `data` to the AccelByte backend, and uploads the business partner certificate if it differs from `previous`.
+// Used by both Create and Update, since the API creates the configuration if it does not exist yet.
+func (r *AccelBytePlatformIapConfigXboxResource) write(ctx context.Context, data *AccelBytePlatformIapConfigXboxModel, previous *AccelBytePlatformIapConfigXboxModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	// Decode the certificate before writing anything, so that an invalid certificate does not leave the configuration half-written
+	certChanged := previous == nil || !data.BusinessPartnerCertP12.Equal(previous.BusinessPartnerCertP12) || !data.BusinessPartnerCertPassword.Equal(previous.BusinessPartnerCertPassword)
+	uploadCert := !data.BusinessPartnerCertP12.IsNull() && certChanged
+	var cert []byte
+	if uploadCert {
+		var err error
+		cert, err = base64.StdEncoding.DecodeString(data.BusinessPartnerCertP12.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("business_partner_cert_p12"), "Invalid business partner certificate", fmt.Sprintf("Unable to decode base64-encoded business partner certificate, got error: %s", err))
+			return diags
+		}
+	}
+
+	input := &iap.UpdateXblIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      toApiPlatformIapConfigXbox(*data),
+	}
+
+	xboxConfig, err := r.client.UpdateXblIAPConfigShort(input)
+	if err != nil {
+		diags.AddError("Error when writing Xbox IAP configuration via AccelByte API", fmt.Sprintf("Unable to write Xbox IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return diags
+	}
+
+	if uploadCert {
+		tflog.Trace(ctx, "Uploading Xbox IAP business partner certificate via AccelByte API", map[string]interface{}{
+			"namespace": data.Namespace,
+		})
+
+		fileInput := &iap.UpdateXblBPCertFileParams{
+			Namespace: data.Namespace.ValueString(),
+			File:      runtime.NamedReader("business-partner.p12", bytes.NewReader(cert)),
+			Password:  data.BusinessPartnerCertPassword.ValueStringPointer(),
+		}
+
+		xboxConfig, err = r.client.UpdateXblBPCertFileShort(fileInput)
+		if err != nil {
+			diags.AddError("Error when uploading Xbox IAP business partner certificate via AccelByte API", fmt.Sprintf("Unable to upload business partner certificate for Xbox IAP configuration in namespace '%s', got error: %s", fileInput.Namespace, err))
+			return diags
+		}
+	}
+
+	updateFromApiPlatformIapConfigXbox(data, xboxConfig)
+
+	return diags
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformIapConfigXboxModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating Xbox IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformIapConfigXboxModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API returns a configuration even when none has been set up, and none of its fields are mandatory
+	// The resource is therefore never considered to have disappeared from the AccelByte backend
+	input := &iap.GetXblIAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	xboxConfig, err := r.client.GetXblIAPConfigShort(input)
+	if err != nil {
+		// Failed to retrieve the resource from the AccelByte backend
+		// This is an actual error; do not update Terraform state, and signal an error to Terraform
+		resp.Diagnostics.AddError("Error when reading Xbox IAP configuration via AccelByte API", fmt.Sprintf("Unable to read Xbox IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	tflog.Trace(ctx, "Read Xbox IAP configuration from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	updateFromApiPlatformIapConfigXbox(&data, xboxConfig)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformIapConfigXboxModel
+	var state AccelBytePlatformIapConfigXboxModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating Xbox IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformIapConfigXboxModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting Xbox IAP configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	input := &iap.DeleteXblAPConfigParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	err := r.client.DeleteXblAPConfigShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting Xbox IAP configuration via AccelByte API", fmt.Sprintf("Unable to delete Xbox IAP configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformIapConfigXboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
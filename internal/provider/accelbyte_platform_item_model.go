@@ -0,0 +1,565 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AccelBytePlatformItemModel is used by AccelBytePlatformItemResource.
+type AccelBytePlatformItemModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	StoreId   types.String `tfsdk:"store_id"`
+
+	// Computed during Create() operation
+	ItemId types.String `tfsdk:"item_id"`
+	Id     types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	ItemType      types.String `tfsdk:"item_type"`
+	Name          types.String `tfsdk:"name"`
+	CategoryPath  types.String `tfsdk:"category_path"`
+	Localizations types.Map    `tfsdk:"localizations"` // map[string]AccelBytePlatformItemLocalizationModel
+	RegionData    types.Set    `tfsdk:"region_data"`   // []AccelBytePlatformItemRegionDataModel
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or the API
+	EntitlementType types.String `tfsdk:"entitlement_type"`
+	Status          types.String `tfsdk:"status"`
+	Sku             types.String `tfsdk:"sku"`
+	UseCount        types.Int32  `tfsdk:"use_count"`
+	Stackable       types.Bool   `tfsdk:"stackable"`
+	Purchasable     types.Bool   `tfsdk:"purchasable"`
+	Listable        types.Bool   `tfsdk:"listable"`
+	MaxCount        types.Int32  `tfsdk:"max_count"`
+	MaxCountPerUser types.Int32  `tfsdk:"max_count_per_user"`
+	Tags            types.Set    `tfsdk:"tags"` // []string
+
+	// Settings specific to each item type; at most one of these is set, depending on item_type
+	Coins        types.Object `tfsdk:"coins"`        // AccelBytePlatformItemCoinsModel
+	Bundle       types.Object `tfsdk:"bundle"`       // AccelBytePlatformItemBundleModel
+	Season       types.Object `tfsdk:"season"`       // AccelBytePlatformItemSeasonModel
+	Subscription types.Object `tfsdk:"subscription"` // AccelBytePlatformItemSubscriptionModel
+	Code         types.Object `tfsdk:"code"`         // AccelBytePlatformItemCodeModel
+	Lootbox      types.Object `tfsdk:"lootbox"`      // AccelBytePlatformItemLootboxModel
+}
+
+var AccelBytePlatformItemLocalizationModelAttributeTypes = map[string]attr.Type{
+	"title":            types.StringType,
+	"description":      types.StringType,
+	"long_description": types.StringType,
+}
+
+type AccelBytePlatformItemLocalizationModel struct {
+	Title           types.String `tfsdk:"title"`
+	Description     types.String `tfsdk:"description"`
+	LongDescription types.String `tfsdk:"long_description"`
+}
+
+var AccelBytePlatformItemRegionDataModelAttributeTypes = map[string]attr.Type{
+	"region":               types.StringType,
+	"currency_code":        types.StringType,
+	"currency_namespace":   types.StringType,
+	"currency_type":        types.StringType,
+	"price":                types.Int32Type,
+	"discount_percentage":  types.Int32Type,
+	"discount_amount":      types.Int32Type,
+	"purchase_at":          types.StringType,
+	"expire_at":            types.StringType,
+	"discount_purchase_at": types.StringType,
+	"discount_expire_at":   types.StringType,
+	"trial_price":          types.Int32Type,
+}
+
+type AccelBytePlatformItemRegionDataModel struct {
+	Region             types.String `tfsdk:"region"`
+	CurrencyCode       types.String `tfsdk:"currency_code"`
+	CurrencyNamespace  types.String `tfsdk:"currency_namespace"`
+	CurrencyType       types.String `tfsdk:"currency_type"`
+	Price              types.Int32  `tfsdk:"price"`
+	DiscountPercentage types.Int32  `tfsdk:"discount_percentage"`
+	DiscountAmount     types.Int32  `tfsdk:"discount_amount"`
+	PurchaseAt         types.String `tfsdk:"purchase_at"`
+	ExpireAt           types.String `tfsdk:"expire_at"`
+	DiscountPurchaseAt types.String `tfsdk:"discount_purchase_at"`
+	DiscountExpireAt   types.String `tfsdk:"discount_expire_at"`
+	TrialPrice         types.Int32  `tfsdk:"trial_price"`
+}
+
+var AccelBytePlatformItemCoinsModelAttributeTypes = map[string]attr.Type{
+	"target_currency_code": types.StringType,
+}
+
+type AccelBytePlatformItemCoinsModel struct {
+	TargetCurrencyCode types.String `tfsdk:"target_currency_code"`
+}
+
+var AccelBytePlatformItemBundleModelAttributeTypes = map[string]attr.Type{
+	"bundled_items": types.MapType{ElemType: types.Int32Type},
+	"flexible":      types.BoolType,
+}
+
+type AccelBytePlatformItemBundleModel struct {
+	BundledItems types.Map  `tfsdk:"bundled_items"` // map[string]int32
+	Flexible     types.Bool `tfsdk:"flexible"`
+}
+
+var AccelBytePlatformItemSeasonModelAttributeTypes = map[string]attr.Type{
+	"season_type": types.StringType,
+}
+
+type AccelBytePlatformItemSeasonModel struct {
+	SeasonType types.String `tfsdk:"season_type"`
+}
+
+var AccelBytePlatformItemSubscriptionModelAttributeTypes = map[string]attr.Type{
+	"cycle":              types.StringType,
+	"fixed_free_days":    types.Int32Type,
+	"fixed_trial_cycles": types.Int32Type,
+	"grace_days":         types.Int32Type,
+}
+
+type AccelBytePlatformItemSubscriptionModel struct {
+	Cycle            types.String `tfsdk:"cycle"`
+	FixedFreeDays    types.Int32  `tfsdk:"fixed_free_days"`
+	FixedTrialCycles types.Int32  `tfsdk:"fixed_trial_cycles"`
+	GraceDays        types.Int32  `tfsdk:"grace_days"`
+}
+
+var AccelBytePlatformItemCodeModelAttributeTypes = map[string]attr.Type{
+	"booth_name": types.StringType,
+}
+
+type AccelBytePlatformItemCodeModel struct {
+	BoothName types.String `tfsdk:"booth_name"`
+}
+
+var AccelBytePlatformItemLootboxItemModelAttributeTypes = map[string]attr.Type{
+	"item_id": types.StringType,
+	"count":   types.Int32Type,
+}
+
+type AccelBytePlatformItemLootboxItemModel struct {
+	ItemId types.String `tfsdk:"item_id"`
+	Count  types.Int32  `tfsdk:"count"`
+}
+
+var AccelBytePlatformItemLootboxRewardModelAttributeTypes = map[string]attr.Type{
+	"name":   types.StringType,
+	"type":   types.StringType,
+	"weight": types.Int32Type,
+	"items":  types.ListType{ElemType: types.ObjectType{AttrTypes: AccelBytePlatformItemLootboxItemModelAttributeTypes}},
+}
+
+type AccelBytePlatformItemLootboxRewardModel struct {
+	Name   types.String `tfsdk:"name"`
+	Type   types.String `tfsdk:"type"`
+	Weight types.Int32  `tfsdk:"weight"`
+	Items  types.List   `tfsdk:"items"` // []AccelBytePlatformItemLootboxItemModel
+}
+
+var AccelBytePlatformItemLootboxModelAttributeTypes = map[string]attr.Type{
+	"reward_count":  types.Int32Type,
+	"roll_function": types.StringType,
+	"rewards":       types.ListType{ElemType: types.ObjectType{AttrTypes: AccelBytePlatformItemLootboxRewardModelAttributeTypes}},
+}
+
+type AccelBytePlatformItemLootboxModel struct {
+	RewardCount  types.Int32  `tfsdk:"reward_count"`
+	RollFunction types.String `tfsdk:"roll_function"`
+	Rewards      types.List   `tfsdk:"rewards"` // []AccelBytePlatformItemLootboxRewardModel
+}
+
+// Item timestamps are always stored in UTC, without fractional seconds.
+// This keeps them stable inside sets, where there is no prior value to compare against; see platformItemTimestampValidator.
+func fromApiPlatformItemTimestamp(apiValue *strfmt.DateTime) types.String {
+	return fromApiTimestamp(types.StringNull(), apiValue)
+}
+
+func toApiPlatformItemTimestamp(value types.String) (*strfmt.DateTime, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	dateTime := strfmt.DateTime(timestamp)
+	return &dateTime, nil
+}
+
+// Optional integers which the API omits when they are zero are treated as not set.
+func fromApiPlatformItemOptionalInt32(apiValue int32) types.Int32 {
+	if apiValue == 0 {
+		return types.Int32Null()
+	}
+	return types.Int32Value(apiValue)
+}
+
+// Used by Create, Read and Update operations on items.
+// This copies data from the AccelByte API `item` to the TF state `data`.
+func updateFromApiPlatformItem(ctx context.Context, data *AccelBytePlatformItemModel, item *platformclientmodels.FullItemInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*item.Namespace)
+	data.ItemId = types.StringValue(*item.ItemID)
+	data.Id = types.StringValue(computePlatformItemId(*item.Namespace, data.StoreId.ValueString(), *item.ItemID))
+
+	data.ItemType = types.StringPointerValue(item.ItemType)
+	data.Name = types.StringPointerValue(item.Name)
+	data.CategoryPath = types.StringValue(item.CategoryPath)
+
+	localizations := map[string]AccelBytePlatformItemLocalizationModel{}
+	for language, localization := range item.Localizations {
+		localizations[language] = AccelBytePlatformItemLocalizationModel{
+			Title:           types.StringPointerValue(localization.Title),
+			Description:     types.StringValue(localization.Description),
+			LongDescription: types.StringValue(localization.LongDescription),
+		}
+	}
+	localizationsMap, localizationsDiags := types.MapValueFrom(ctx, types.ObjectType{AttrTypes: AccelBytePlatformItemLocalizationModelAttributeTypes}, localizations)
+	data.Localizations = localizationsMap
+	diags.Append(localizationsDiags...)
+
+	regionData := []AccelBytePlatformItemRegionDataModel{}
+	for region, regionDataItems := range item.RegionData {
+		for _, regionDataItem := range regionDataItems {
+			regionData = append(regionData, AccelBytePlatformItemRegionDataModel{
+				Region:             types.StringValue(region),
+				CurrencyCode:       types.StringPointerValue(regionDataItem.CurrencyCode),
+				CurrencyNamespace:  types.StringPointerValue(regionDataItem.CurrencyNamespace),
+				CurrencyType:       types.StringPointerValue(regionDataItem.CurrencyType),
+				Price:              types.Int32Value(regionDataItem.Price),
+				DiscountPercentage: fromApiPlatformItemOptionalInt32(regionDataItem.DiscountPercentage),
+				DiscountAmount:     fromApiPlatformItemOptionalInt32(regionDataItem.DiscountAmount),
+				PurchaseAt:         fromApiPlatformItemTimestamp(regionDataItem.PurchaseAt),
+				ExpireAt:           fromApiPlatformItemTimestamp(regionDataItem.ExpireAt),
+				DiscountPurchaseAt: fromApiPlatformItemTimestamp(regionDataItem.DiscountPurchaseAt),
+				DiscountExpireAt:   fromApiPlatformItemTimestamp(regionDataItem.DiscountExpireAt),
+				TrialPrice:         fromApiPlatformItemOptionalInt32(regionDataItem.TrialPrice),
+			})
+		}
+	}
+	regionDataSet, regionDataDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelBytePlatformItemRegionDataModelAttributeTypes}, regionData)
+	data.RegionData = regionDataSet
+	diags.Append(regionDataDiags...)
+
+	data.EntitlementType = types.StringPointerValue(item.EntitlementType)
+	data.Status = types.StringPointerValue(item.Status)
+	data.Sku = types.StringValue(item.Sku)
+	data.UseCount = types.Int32Value(item.UseCount)
+	data.Stackable = types.BoolValue(item.Stackable)
+	data.Purchasable = types.BoolValue(item.Purchasable)
+	data.Listable = types.BoolValue(item.Listable)
+	data.MaxCount = types.Int32Value(item.MaxCount)
+	data.MaxCountPerUser = types.Int32Value(item.MaxCountPerUser)
+
+	tags, tagsDiags := setValueFromEvenIfNil(ctx, types.StringType, item.Tags)
+	data.Tags = tags
+	diags.Append(tagsDiags...)
+
+	itemType := data.ItemType.ValueString()
+
+	data.Coins = types.ObjectNull(AccelBytePlatformItemCoinsModelAttributeTypes)
+	if itemType == platformclientmodels.ItemCreateItemTypeCOINS {
+		coins, coinsDiags := types.ObjectValueFrom(ctx, AccelBytePlatformItemCoinsModelAttributeTypes, AccelBytePlatformItemCoinsModel{
+			TargetCurrencyCode: types.StringValue(item.TargetCurrencyCode),
+		})
+		data.Coins = coins
+		diags.Append(coinsDiags...)
+	}
+
+	data.Bundle = types.ObjectNull(AccelBytePlatformItemBundleModelAttributeTypes)
+	if itemType == platformclientmodels.ItemCreateItemTypeBUNDLE {
+		bundledItems := map[string]types.Int32{}
+		for _, itemId := range item.ItemIds {
+			quantity, ok := item.ItemQty[itemId]
+			if !ok {
+				// The API treats a missing quantity as 1
+				quantity = 1
+			}
+			bundledItems[itemId] = types.Int32Value(quantity)
+		}
+		bundledItemsMap, bundledItemsDiags := types.MapValueFrom(ctx, types.Int32Type, bundledItems)
+		diags.Append(bundledItemsDiags...)
+
+		bundle, bundleDiags := types.ObjectValueFrom(ctx, AccelBytePlatformItemBundleModelAttributeTypes, AccelBytePlatformItemBundleModel{
+			BundledItems: bundledItemsMap,
+			Flexible:     types.BoolValue(item.Flexible),
+		})
+		data.Bundle = bundle
+		diags.Append(bundleDiags...)
+	}
+
+	data.Season = types.ObjectNull(AccelBytePlatformItemSeasonModelAttributeTypes)
+	if itemType == platformclientmodels.ItemCreateItemTypeSEASON {
+		season, seasonDiags := types.ObjectValueFrom(ctx, AccelBytePlatformItemSeasonModelAttributeTypes, AccelBytePlatformItemSeasonModel{
+			SeasonType: types.StringValue(item.SeasonType),
+		})
+		data.Season = season
+		diags.Append(seasonDiags...)
+	}
+
+	data.Subscription = types.ObjectNull(AccelBytePlatformItemSubscriptionModelAttributeTypes)
+	if itemType == platformclientmodels.ItemCreateItemTypeSUBSCRIPTION && item.Recurring != nil {
+		subscription, subscriptionDiags := types.ObjectValueFrom(ctx, AccelBytePlatformItemSubscriptionModelAttributeTypes, AccelBytePlatformItemSubscriptionModel{
+			Cycle:            types.StringPointerValue(item.Recurring.Cycle),
+			FixedFreeDays:    types.Int32PointerValue(item.Recurring.FixedFreeDays),
+			FixedTrialCycles: types.Int32PointerValue(item.Recurring.FixedTrialCycles),
+			GraceDays:        types.Int32PointerValue(item.Recurring.GraceDays),
+		})
+		data.Subscription = subscription
+		diags.Append(subscriptionDiags...)
+	}
+
+	data.Code = types.ObjectNull(AccelBytePlatformItemCodeModelAttributeTypes)
+	if itemType == platformclientmodels.ItemCreateItemTypeCODE {
+		code, codeDiags := types.ObjectValueFrom(ctx, AccelBytePlatformItemCodeModelAttributeTypes, AccelBytePlatformItemCodeModel{
+			BoothName: types.StringValue(item.BoothName),
+		})
+		data.Code = code
+		diags.Append(codeDiags...)
+	}
+
+	data.Lootbox = types.ObjectNull(AccelBytePlatformItemLootboxModelAttributeTypes)
+	if itemType == platformclientmodels.ItemCreateItemTypeLOOTBOX && item.LootBoxConfig != nil {
+		rewards := []AccelBytePlatformItemLootboxRewardModel{}
+		for _, reward := range item.LootBoxConfig.Rewards {
+			items := []AccelBytePlatformItemLootboxItemModel{}
+			for _, lootBoxItem := range reward.LootBoxItems {
+				items = append(items, AccelBytePlatformItemLootboxItemModel{
+					ItemId: types.StringValue(lootBoxItem.ItemID),
+					Count:  types.Int32Value(lootBoxItem.Count),
+				})
+			}
+			itemsList, itemsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelBytePlatformItemLootboxItemModelAttributeTypes}, items)
+			diags.Append(itemsDiags...)
+
+			rewards = append(rewards, AccelBytePlatformItemLootboxRewardModel{
+				Name:   types.StringValue(reward.Name),
+				Type:   types.StringValue(reward.Type),
+				Weight: types.Int32Value(reward.Weight),
+				Items:  itemsList,
+			})
+		}
+		rewardsList, rewardsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelBytePlatformItemLootboxRewardModelAttributeTypes}, rewards)
+		diags.Append(rewardsDiags...)
+
+		lootbox, lootboxDiags := types.ObjectValueFrom(ctx, AccelBytePlatformItemLootboxModelAttributeTypes, AccelBytePlatformItemLootboxModel{
+			RewardCount:  types.Int32Value(item.LootBoxConfig.RewardCount),
+			RollFunction: types.StringValue(item.LootBoxConfig.RollFunction),
+			Rewards:      rewardsList,
+		})
+		data.Lootbox = lootbox
+		diags.Append(lootboxDiags...)
+	}
+
+	return diags
+}
+
+// Used by Create/Update operations on items.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// The create and update APIs accept nearly identical request bodies; see toApiPlatformItemUpdate().
+func toApiPlatformItemCreate(ctx context.Context, data AccelBytePlatformItemModel) (*platformclientmodels.ItemCreate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	localizationModels := map[string]AccelBytePlatformItemLocalizationModel{}
+	diags.Append(data.Localizations.ElementsAs(ctx, &localizationModels, false)...)
+
+	localizations := map[string]platformclientmodels.Localization{}
+	for language, localizationModel := range localizationModels {
+		localizations[language] = platformclientmodels.Localization{
+			Title:           localizationModel.Title.ValueStringPointer(),
+			Description:     localizationModel.Description.ValueString(),
+			LongDescription: localizationModel.LongDescription.ValueString(),
+		}
+	}
+
+	regionDataModels := []AccelBytePlatformItemRegionDataModel{}
+	diags.Append(data.RegionData.ElementsAs(ctx, &regionDataModels, false)...)
+
+	// The API groups prices by region
+	regionData := map[string][]platformclientmodels.RegionDataItemDTO{}
+	for _, regionDataModel := range regionDataModels {
+		region := regionDataModel.Region.ValueString()
+		regionDataItem := platformclientmodels.RegionDataItemDTO{
+			CurrencyCode:       regionDataModel.CurrencyCode.ValueStringPointer(),
+			CurrencyNamespace:  regionDataModel.CurrencyNamespace.ValueStringPointer(),
+			CurrencyType:       regionDataModel.CurrencyType.ValueStringPointer(),
+			Price:              regionDataModel.Price.ValueInt32Pointer(),
+			DiscountPercentage: regionDataModel.DiscountPercentage.ValueInt32(),
+			DiscountAmount:     regionDataModel.DiscountAmount.ValueInt32(),
+			TrialPrice:         regionDataModel.TrialPrice.ValueInt32(),
+		}
+
+		for _, timestamp := range []struct {
+			value  types.String
+			target **strfmt.DateTime
+		}{
+			{regionDataModel.PurchaseAt, &regionDataItem.PurchaseAt},
+			{regionDataModel.ExpireAt, &regionDataItem.ExpireAt},
+			{regionDataModel.DiscountPurchaseAt, &regionDataItem.DiscountPurchaseAt},
+			{regionDataModel.DiscountExpireAt, &regionDataItem.DiscountExpireAt},
+		} {
+			dateTime, err := toApiPlatformItemTimestamp(timestamp.value)
+			if err != nil {
+				diags.AddError("Invalid timestamp", fmt.Sprintf("Unable to parse timestamp '%s' in region data for region '%s', got error: %s", timestamp.value.ValueString(), region, err))
+				continue
+			}
+			*timestamp.target = dateTime
+		}
+
+		regionData[region] = append(regionData[region], regionDataItem)
+	}
+
+	tags := []string{}
+	diags.Append(data.Tags.ElementsAs(ctx, &tags, false)...)
+
+	item := &platformclientmodels.ItemCreate{
+		ItemType:        data.ItemType.ValueStringPointer(),
+		Name:            data.Name.ValueStringPointer(),
+		CategoryPath:    data.CategoryPath.ValueStringPointer(),
+		Localizations:   localizations,
+		RegionData:      regionData,
+		EntitlementType: data.EntitlementType.ValueStringPointer(),
+		Status:          data.Status.ValueStringPointer(),
+		Sku:             data.Sku.ValueString(),
+		UseCount:        data.UseCount.ValueInt32(),
+		Stackable:       data.Stackable.ValueBool(),
+		Purchasable:     data.Purchasable.ValueBool(),
+		Listable:        data.Listable.ValueBool(),
+		MaxCount:        data.MaxCount.ValueInt32(),
+		MaxCountPerUser: data.MaxCountPerUser.ValueInt32(),
+		Tags:            tags,
+	}
+
+	if !data.Coins.IsNull() && !data.Coins.IsUnknown() {
+		var coins AccelBytePlatformItemCoinsModel
+		diags.Append(data.Coins.As(ctx, &coins, basetypes.ObjectAsOptions{})...)
+		item.TargetCurrencyCode = coins.TargetCurrencyCode.ValueString()
+	}
+
+	if !data.Bundle.IsNull() && !data.Bundle.IsUnknown() {
+		var bundle AccelBytePlatformItemBundleModel
+		diags.Append(data.Bundle.As(ctx, &bundle, basetypes.ObjectAsOptions{})...)
+
+		bundledItems := map[string]int32{}
+		diags.Append(bundle.BundledItems.ElementsAs(ctx, &bundledItems, false)...)
+
+		item.ItemIds = []string{}
+		for itemId := range bundledItems {
+			item.ItemIds = append(item.ItemIds, itemId)
+		}
+		item.ItemQty = bundledItems
+		item.Flexible = bundle.Flexible.ValueBool()
+	}
+
+	if !data.Season.IsNull() && !data.Season.IsUnknown() {
+		var season AccelBytePlatformItemSeasonModel
+		diags.Append(data.Season.As(ctx, &season, basetypes.ObjectAsOptions{})...)
+		item.SeasonType = season.SeasonType.ValueString()
+	}
+
+	if !data.Subscription.IsNull() && !data.Subscription.IsUnknown() {
+		var subscription AccelBytePlatformItemSubscriptionModel
+		diags.Append(data.Subscription.As(ctx, &subscription, basetypes.ObjectAsOptions{})...)
+		item.Recurring = &platformclientmodels.Recurring{
+			Cycle:            subscription.Cycle.ValueStringPointer(),
+			FixedFreeDays:    subscription.FixedFreeDays.ValueInt32Pointer(),
+			FixedTrialCycles: subscription.FixedTrialCycles.ValueInt32Pointer(),
+			GraceDays:        subscription.GraceDays.ValueInt32Pointer(),
+		}
+	}
+
+	if !data.Code.IsNull() && !data.Code.IsUnknown() {
+		var code AccelBytePlatformItemCodeModel
+		diags.Append(data.Code.As(ctx, &code, basetypes.ObjectAsOptions{})...)
+		item.BoothName = code.BoothName.ValueString()
+	}
+
+	if !data.Lootbox.IsNull() && !data.Lootbox.IsUnknown() {
+		var lootbox AccelBytePlatformItemLootboxModel
+		diags.Append(data.Lootbox.As(ctx, &lootbox, basetypes.ObjectAsOptions{})...)
+
+		rewardModels := []AccelBytePlatformItemLootboxRewardModel{}
+		diags.Append(lootbox.Rewards.ElementsAs(ctx, &rewardModels, false)...)
+
+		rewards := []*platformclientmodels.LootBoxReward{}
+		for _, rewardModel := range rewardModels {
+			itemModels := []AccelBytePlatformItemLootboxItemModel{}
+			diags.Append(rewardModel.Items.ElementsAs(ctx, &itemModels, false)...)
+
+			lootBoxItems := []*platformclientmodels.BoxItem{}
+			for _, itemModel := range itemModels {
+				lootBoxItems = append(lootBoxItems, &platformclientmodels.BoxItem{
+					ItemID: itemModel.ItemId.ValueString(),
+					Count:  itemModel.Count.ValueInt32(),
+				})
+			}
+
+			rewards = append(rewards, &platformclientmodels.LootBoxReward{
+				Name:         rewardModel.Name.ValueString(),
+				Type:         rewardModel.Type.ValueString(),
+				Weight:       rewardModel.Weight.ValueInt32(),
+				LootBoxItems: lootBoxItems,
+			})
+		}
+
+		item.LootBoxConfig = &platformclientmodels.LootBoxConfig{
+			RewardCount:  lootbox.RewardCount.ValueInt32(),
+			RollFunction: lootbox.RollFunction.ValueString(),
+			Rewards:      rewards,
+		}
+	}
+
+	return item, diags
+}
+
+// Used by the Update operation on items.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformItemUpdate(ctx context.Context, data AccelBytePlatformItemModel) (*platformclientmodels.ItemUpdate, diag.Diagnostics) {
+
+	item, diags := toApiPlatformItemCreate(ctx, data)
+
+	return &platformclientmodels.ItemUpdate{
+		ItemType:           item.ItemType,
+		Name:               *item.Name,
+		CategoryPath:       *item.CategoryPath,
+		Localizations:      item.Localizations,
+		RegionData:         item.RegionData,
+		EntitlementType:    item.EntitlementType,
+		Status:             *item.Status,
+		Sku:                item.Sku,
+		UseCount:           item.UseCount,
+		Stackable:          item.Stackable,
+		Purchasable:        item.Purchasable,
+		Listable:           item.Listable,
+		MaxCount:           item.MaxCount,
+		MaxCountPerUser:    item.MaxCountPerUser,
+		Tags:               item.Tags,
+		TargetCurrencyCode: item.TargetCurrencyCode,
+		ItemIds:            item.ItemIds,
+		ItemQty:            item.ItemQty,
+		Flexible:           item.Flexible,
+		SeasonType:         item.SeasonType,
+		Recurring:          item.Recurring,
+		BoothName:          item.BoothName,
+		LootBoxConfig:      item.LootBoxConfig,
+	}, diags
+}
+
+func computePlatformItemId(namespace string, storeId string, itemId string) string {
+	return fmt.Sprintf("%s/%s/%s", namespace, storeId, itemId)
+}
@@ -0,0 +1,760 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/item"
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformItemResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformItemResource{}
+var _ resource.ResourceWithValidateConfig = &AccelBytePlatformItemResource{}
+
+func NewAccelBytePlatformItemResource() resource.Resource {
+	return &AccelBytePlatformItemResource{}
+}
+
+// AccelBytePlatformItemResource defines the resource implementation.
+type AccelBytePlatformItemResource struct {
+	client *platform.ItemService
+}
+
+// Item types supported by this resource, and the nested attribute which holds the settings specific to each type.
+// An empty attribute name means that the item type has no type-specific settings.
+var platformItemTypeAttributes = map[string]string{
+	platformclientmodels.ItemCreateItemTypeINGAMEITEM:   "",
+	platformclientmodels.ItemCreateItemTypeCOINS:        "coins",
+	platformclientmodels.ItemCreateItemTypeBUNDLE:       "bundle",
+	platformclientmodels.ItemCreateItemTypeSEASON:       "season",
+	platformclientmodels.ItemCreateItemTypeSUBSCRIPTION: "subscription",
+	platformclientmodels.ItemCreateItemTypeCODE:         "code",
+	platformclientmodels.ItemCreateItemTypeLOOTBOX:      "lootbox",
+}
+
+// Timestamps within region data must be in UTC, so that they read back identically from the API.
+// Region data is a set, so there is no prior value from which fromApiTimestamp could retain the user's formatting.
+var platformItemTimestampValidator = stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}Z$`), "must be an RFC3339 timestamp in UTC, without fractional seconds, for example `2025-01-01T00:00:00Z`")
+
+func (r *AccelBytePlatformItemResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_item"
+}
+
+func (r *AccelBytePlatformItemResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents an [item](https://docs.accelbyte.io/gaming-services/services/monetization/store/) in a store. Items are what players buy or receive: in-game items, virtual currency packs, bundles, season passes, subscriptions, redeemable codes and loot boxes.\n\nSettings which only apply to one item type are grouped in a nested attribute named after that type, such as `coins` or `bundle`. The nested attribute for the chosen `item_type` must be set, and the others must be left unset.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the store. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the store which contains the item. Normally this is the draft store.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"item_id": schema.StringAttribute{
+				MarkdownDescription: "Item ID, as assigned by the Platform service when the item was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Item identifier, on the format `{{namespace}}/{{store_id}}/{{item_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"item_type": schema.StringAttribute{
+				MarkdownDescription: "Type of item. Allowed values: `INGAMEITEM`, `COINS`, `BUNDLE`, `SEASON`, `SUBSCRIPTION`, `CODE`, `LOOTBOX`. Changing this forces the item to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						platformclientmodels.ItemCreateItemTypeINGAMEITEM,
+						platformclientmodels.ItemCreateItemTypeCOINS,
+						platformclientmodels.ItemCreateItemTypeBUNDLE,
+						platformclientmodels.ItemCreateItemTypeSEASON,
+						platformclientmodels.ItemCreateItemTypeSUBSCRIPTION,
+						platformclientmodels.ItemCreateItemTypeCODE,
+						platformclientmodels.ItemCreateItemTypeLOOTBOX,
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Internal name of the item. Players see the localized titles instead. Max 127 characters in length.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+				},
+			},
+			"category_path": schema.StringAttribute{
+				MarkdownDescription: "Path of the store category which contains the item, for example `/games/rpg`. See the `accelbyte_platform_category` resource.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"localizations": schema.MapNestedAttribute{
+				MarkdownDescription: "Player-facing texts of the item, per language. Keys are language codes, for example `en`.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Title of the item.",
+							Required:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Short description of the item.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"long_description": schema.StringAttribute{
+							MarkdownDescription: "Long description of the item.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+					},
+				},
+				Required: true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"region_data": schema.SetNestedAttribute{
+				MarkdownDescription: "Prices of the item, typically one per region and currency. The prices are unordered, so reordering them does not cause a change.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"region": schema.StringAttribute{
+							MarkdownDescription: "Region that the price applies to, as an ISO 3166-1 alpha-2 country code, for example `US`.",
+							Required:            true,
+						},
+						"currency_code": schema.StringAttribute{
+							MarkdownDescription: "Code of the currency, for example `USD`.",
+							Required:            true,
+						},
+						"currency_namespace": schema.StringAttribute{
+							MarkdownDescription: "Namespace which contains the currency.",
+							Required:            true,
+						},
+						"currency_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the currency. Allowed values: `REAL`, `VIRTUAL`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(platformclientmodels.RegionDataItemDTOCurrencyTypeREAL, platformclientmodels.RegionDataItemDTOCurrencyTypeVIRTUAL),
+							},
+						},
+						"price": schema.Int32Attribute{
+							MarkdownDescription: "Price, in the currency's smallest unit, for example cents. `0` means free.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(0),
+							},
+						},
+						"discount_percentage": schema.Int32Attribute{
+							MarkdownDescription: "Discount, in percent. Overrides `discount_amount`.",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 100),
+							},
+						},
+						"discount_amount": schema.Int32Attribute{
+							MarkdownDescription: "Discount, in the currency's smallest unit.",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"discount_purchase_at": schema.StringAttribute{
+							MarkdownDescription: "Start of the discount window, as an RFC3339 timestamp in UTC, for example `2025-01-01T00:00:00Z`.",
+							Optional:            true,
+							Validators: []validator.String{
+								platformItemTimestampValidator,
+							},
+						},
+						"discount_expire_at": schema.StringAttribute{
+							MarkdownDescription: "End of the discount window, as an RFC3339 timestamp in UTC, for example `2025-01-01T00:00:00Z`.",
+							Optional:            true,
+							Validators: []validator.String{
+								platformItemTimestampValidator,
+							},
+						},
+						"purchase_at": schema.StringAttribute{
+							MarkdownDescription: "Time from which the item can be purchased at this price, as an RFC3339 timestamp in UTC, for example `2025-01-01T00:00:00Z`.",
+							Optional:            true,
+							Validators: []validator.String{
+								platformItemTimestampValidator,
+							},
+						},
+						"expire_at": schema.StringAttribute{
+							MarkdownDescription: "Time after which the item can no longer be purchased at this price, as an RFC3339 timestamp in UTC, for example `2025-01-01T00:00:00Z`.",
+							Optional:            true,
+							Validators: []validator.String{
+								platformItemTimestampValidator,
+							},
+						},
+						"trial_price": schema.Int32Attribute{
+							MarkdownDescription: "For subscriptions, the price of each trial cycle, in the currency's smallest unit.",
+							Optional:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+					},
+				},
+				Required: true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema or from the API
+
+			"entitlement_type": schema.StringAttribute{
+				MarkdownDescription: "Type of entitlement that players receive for the item. `CONSUMABLE` entitlements can be used up; `DURABLE` entitlements last forever. Allowed values: `CONSUMABLE`, `DURABLE`. Default: `DURABLE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(platformclientmodels.ItemCreateEntitlementTypeDURABLE),
+				Validators: []validator.String{
+					stringvalidator.OneOf(platformclientmodels.ItemCreateEntitlementTypeCONSUMABLE, platformclientmodels.ItemCreateEntitlementTypeDURABLE),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the item. Inactive items are not sold. Allowed values: `ACTIVE`, `INACTIVE`. Default: `ACTIVE`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(platformclientmodels.ItemCreateStatusACTIVE),
+				Validators: []validator.String{
+					stringvalidator.OneOf(platformclientmodels.ItemCreateStatusACTIVE, platformclientmodels.ItemCreateStatusINACTIVE),
+				},
+			},
+			"sku": schema.StringAttribute{
+				MarkdownDescription: "Stock keeping unit of the item. Letters, digits, `_`, `:` and `-`; must start and end with a letter or digit. Max 127 characters in length. The Platform service does not support clearing the SKU once it has been set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_:\-]*[A-Za-z0-9])?$`), "must consist of letters, digits, '_', ':' or '-', and start and end with a letter or digit"),
+				},
+			},
+			"use_count": schema.Int32Attribute{
+				MarkdownDescription: "For `CONSUMABLE` items, the number of uses that a player receives per purchase. For `COINS` items, the amount of currency that a player receives per purchase.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"stackable": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, repeated purchases of a `CONSUMABLE` item add uses to a single entitlement, instead of creating one entitlement per purchase.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"purchasable": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players can purchase the item. Set to `false` for items which are only granted, for example as rewards or as part of bundles.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"listable": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, the item is visible to players in the store.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"max_count": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of times the item can be purchased in total. `-1` means unlimited.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(-1),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(-1),
+				},
+			},
+			"max_count_per_user": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of times each player can purchase the item. `-1` means unlimited.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(-1),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(-1),
+				},
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags of the item. Letters, digits, `_`, `:` and `-`; must start and end with a letter or digit. Max 30 characters in length. Max 20 tags per item.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(20),
+					setvalidator.ValueStringsAre(
+						stringvalidator.LengthBetween(1, 30),
+					),
+				},
+			},
+
+			// Settings specific to each item type
+
+			"coins": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for `COINS` items, which grant virtual currency. The amount of currency is set via `use_count`.",
+				Attributes: map[string]schema.Attribute{
+					"target_currency_code": schema.StringAttribute{
+						MarkdownDescription: "Code of the virtual currency that players receive.",
+						Required:            true,
+					},
+				},
+				Optional: true,
+			},
+			"bundle": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for `BUNDLE` items, which grant several other items at once.",
+				Attributes: map[string]schema.Attribute{
+					"bundled_items": schema.MapAttribute{
+						MarkdownDescription: "Items that are part of the bundle. Keys are item IDs; values are quantities.",
+						ElementType:         types.Int32Type,
+						Required:            true,
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+							mapvalidator.ValueInt32sAre(int32validator.AtLeast(1)),
+						},
+					},
+					"flexible": schema.BoolAttribute{
+						MarkdownDescription: "If set to `true`, players who already own some of the bundled items pay a reduced price.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+				Optional: true,
+			},
+			"season": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for `SEASON` items, which grant season passes or tiers.",
+				Attributes: map[string]schema.Attribute{
+					"season_type": schema.StringAttribute{
+						MarkdownDescription: "What the item grants. Allowed values: `PASS`, `TIER`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(platformclientmodels.ItemCreateSeasonTypePASS, platformclientmodels.ItemCreateSeasonTypeTIER),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"subscription": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for `SUBSCRIPTION` items, which are charged periodically.",
+				Attributes: map[string]schema.Attribute{
+					"cycle": schema.StringAttribute{
+						MarkdownDescription: "How often the subscription is charged. Allowed values: `WEEKLY`, `MONTHLY`, `QUARTERLY`, `YEARLY`.",
+						Required:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(platformclientmodels.RecurringCycleWEEKLY, platformclientmodels.RecurringCycleMONTHLY, platformclientmodels.RecurringCycleQUARTERLY, platformclientmodels.RecurringCycleYEARLY),
+						},
+					},
+					"fixed_free_days": schema.Int32Attribute{
+						MarkdownDescription: "Number of free days at the start of the subscription. `0` means none.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int32default.StaticInt32(0),
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					"fixed_trial_cycles": schema.Int32Attribute{
+						MarkdownDescription: "Number of cycles at the start of the subscription which are charged at the trial price. `0` means none.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int32default.StaticInt32(0),
+						Validators: []validator.Int32{
+							int32validator.AtLeast(0),
+						},
+					},
+					"grace_days": schema.Int32Attribute{
+						MarkdownDescription: "Number of days during which a failed charge is retried, before the subscription ends.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int32default.StaticInt32(7),
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+				},
+				Optional: true,
+			},
+			"code": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for `CODE` items, which grant redeemable codes from a campaign.",
+				Attributes: map[string]schema.Attribute{
+					"booth_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the campaign booth that codes are taken from.",
+						Required:            true,
+					},
+				},
+				Optional: true,
+			},
+			"lootbox": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for `LOOTBOX` items, which grant randomly chosen rewards.",
+				Attributes: map[string]schema.Attribute{
+					"reward_count": schema.Int32Attribute{
+						MarkdownDescription: "Number of rewards granted when the loot box is opened.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int32default.StaticInt32(1),
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"roll_function": schema.StringAttribute{
+						MarkdownDescription: "How rewards are chosen. `CUSTOM` requires a roll function to be provided via Extend. Allowed values: `DEFAULT`, `CUSTOM`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(platformclientmodels.LootBoxConfigRollFunctionDEFAULT),
+						Validators: []validator.String{
+							stringvalidator.OneOf(platformclientmodels.LootBoxConfigRollFunctionDEFAULT, platformclientmodels.LootBoxConfigRollFunctionCUSTOM),
+						},
+					},
+					"rewards": schema.ListNestedAttribute{
+						MarkdownDescription: "Rewards that can be chosen. The chance of each reward is proportional to its weight.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									MarkdownDescription: "Name of the reward.",
+									Required:            true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "How the reward's items are granted. `REWARD` grants all items; `PROBABILITY_GROUP` grants one randomly chosen item. Allowed values: `REWARD`, `REWARD_GROUP`, `PROBABILITY_GROUP`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.OneOf(platformclientmodels.LootBoxRewardTypeREWARD, platformclientmodels.LootBoxRewardTypeREWARDGROUP, platformclientmodels.LootBoxRewardTypePROBABILITYGROUP),
+									},
+								},
+								"weight": schema.Int32Attribute{
+									MarkdownDescription: "Weight of the reward.",
+									Required:            true,
+									Validators: []validator.Int32{
+										int32validator.AtLeast(1),
+									},
+								},
+								"items": schema.ListNestedAttribute{
+									MarkdownDescription: "Items granted by the reward.",
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"item_id": schema.StringAttribute{
+												MarkdownDescription: "ID of the item.",
+												Required:            true,
+											},
+											"count": schema.Int32Attribute{
+												MarkdownDescription: "Quantity of the item.",
+												Required:            true,
+												Validators: []validator.Int32{
+													int32validator.AtLeast(1),
+												},
+											},
+										},
+									},
+									Required: true,
+									Validators: []validator.List{
+										listvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+						Required: true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformItemResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelBytePlatformItemModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ItemType.IsUnknown() || data.ItemType.IsNull() {
+		return
+	}
+
+	// Ensure that the type-specific settings match the item type
+
+	itemType := data.ItemType.ValueString()
+	typeAttributes := map[string]types.Object{
+		"coins":        data.Coins,
+		"bundle":       data.Bundle,
+		"season":       data.Season,
+		"subscription": data.Subscription,
+		"code":         data.Code,
+		"lootbox":      data.Lootbox,
+	}
+	for attributeName, value := range typeAttributes {
+		isExpected := platformItemTypeAttributes[itemType] == attributeName
+		if isExpected && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Missing attribute configuration",
+				fmt.Sprintf("Attribute `%s` must be set for items of type '%s'", attributeName, itemType),
+			)
+		} else if !isExpected && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Invalid attribute combination",
+				fmt.Sprintf("Attribute `%s` cannot be set for items of type '%s'", attributeName, itemType),
+			)
+		}
+	}
+}
+
+func (r *AccelBytePlatformItemResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformItemService
+}
+
+func (r *AccelBytePlatformItemResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformItemModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiItem, apiItemDiags := toApiPlatformItemCreate(ctx, data)
+	resp.Diagnostics.Append(apiItemDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating item via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+		"name":      data.Name,
+	})
+
+	input := &item.CreateItemParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueString(),
+		Body:      apiItem,
+	}
+
+	createdItem, err := r.client.CreateItemShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating item via AccelByte API", fmt.Sprintf("Unable to create item '%s' in store '%s' in namespace '%s', got error: %s", data.Name.ValueString(), input.StoreID, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformItem(ctx, &data, createdItem)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformItemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformItemModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Inactive items are managed by this resource as well, so they must not be filtered out
+	activeOnly := false
+	input := &item.GetItemParams{
+		Namespace:  data.Namespace.ValueString(),
+		StoreID:    data.StoreId.ValueStringPointer(),
+		ItemID:     data.ItemId.ValueString(),
+		ActiveOnly: &activeOnly,
+	}
+	apiItem, err := r.client.GetItemShort(input)
+	if err != nil {
+		notFoundError := &item.GetItemNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading item via AccelByte API", fmt.Sprintf("Unable to read item '%s' in store '%s' in namespace '%s', got error: %s", input.ItemID, data.StoreId.ValueString(), input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read item from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+		"itemId":    data.ItemId,
+		"item":      apiItem,
+	})
+
+	resp.Diagnostics.Append(updateFromApiPlatformItem(ctx, &data, apiItem)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformItemModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiItem, apiItemDiags := toApiPlatformItemUpdate(ctx, data)
+	resp.Diagnostics.Append(apiItemDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating item via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+		"itemId":    data.ItemId,
+	})
+
+	input := &item.UpdateItemParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueString(),
+		ItemID:    data.ItemId.ValueString(),
+		Body:      apiItem,
+	}
+
+	updatedItem, err := r.client.UpdateItemShort(input)
+	if err != nil {
+		notFoundError := &item.UpdateItemNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Item '%s' does not exist in store '%s' in namespace '%s'", input.ItemID, input.StoreID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating item via AccelByte API", fmt.Sprintf("Unable to update item '%s' in store '%s' in namespace '%s', got error: %s", input.ItemID, input.StoreID, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformItem(ctx, &data, updatedItem)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformItemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformItemModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting item via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+		"itemId":    data.ItemId,
+	})
+
+	input := &item.DeleteItemParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueStringPointer(),
+		ItemID:    data.ItemId.ValueString(),
+	}
+	err := r.client.DeleteItemShort(input)
+	if err != nil {
+		notFoundError := &item.DeleteItemNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting item via AccelByte API", fmt.Sprintf("Unable to delete item '%s' in store '%s' in namespace '%s', got error: %s", input.ItemID, data.StoreId.ValueString(), input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformItemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "store_id", "item_id")
+}
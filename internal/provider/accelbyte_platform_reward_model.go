@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformRewardModel is used by AccelBytePlatformRewardResource.
+type AccelBytePlatformRewardModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	RewardId types.String `tfsdk:"reward_id"`
+	Id       types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	RewardCode       types.String `tfsdk:"reward_code"`
+	EventTopic       types.String `tfsdk:"event_topic"`
+	RewardConditions types.List   `tfsdk:"reward_conditions"` // []AccelBytePlatformRewardConditionModel
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Description         types.String `tfsdk:"description"`
+	MaxAwarded          types.Int32  `tfsdk:"max_awarded"`
+	MaxAwardedPerUser   types.Int32  `tfsdk:"max_awarded_per_user"`
+	NamespaceExpression types.String `tfsdk:"namespace_expression"`
+	UserIdExpression    types.String `tfsdk:"user_id_expression"`
+}
+
+var AccelBytePlatformRewardItemModelAttributeTypes = map[string]attr.Type{
+	"item_id":  types.StringType,
+	"sku":      types.StringType,
+	"quantity": types.Int32Type,
+	"duration": types.Int32Type,
+	"end_date": types.StringType,
+}
+
+type AccelBytePlatformRewardItemModel struct {
+	ItemId   types.String `tfsdk:"item_id"`
+	Sku      types.String `tfsdk:"sku"`
+	Quantity types.Int32  `tfsdk:"quantity"`
+	Duration types.Int32  `tfsdk:"duration"`
+	EndDate  types.String `tfsdk:"end_date"`
+}
+
+var AccelBytePlatformRewardConditionModelAttributeTypes = map[string]attr.Type{
+	"condition_name": types.StringType,
+	"condition":      types.StringType,
+	"event_name":     types.StringType,
+	"reward_items":   types.ListType{ElemType: types.ObjectType{AttrTypes: AccelBytePlatformRewardItemModelAttributeTypes}},
+}
+
+type AccelBytePlatformRewardConditionModel struct {
+	ConditionName types.String `tfsdk:"condition_name"`
+	Condition     types.String `tfsdk:"condition"`
+	EventName     types.String `tfsdk:"event_name"`
+	RewardItems   types.List   `tfsdk:"reward_items"` // []AccelBytePlatformRewardItemModel
+}
+
+// Optional strings which the API omits when they are empty are treated as not set.
+func fromApiPlatformRewardOptionalString(apiValue string) types.String {
+	if apiValue == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(apiValue)
+}
+
+// Used by Create, Read and Update operations on rewards.
+// This copies data from the AccelByte API `reward` to the TF state `data`.
+func updateFromApiPlatformReward(ctx context.Context, data *AccelBytePlatformRewardModel, reward *platformclientmodels.RewardInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*reward.Namespace)
+	data.RewardId = types.StringValue(*reward.RewardID)
+	data.Id = types.StringValue(computePlatformRewardId(*reward.Namespace, *reward.RewardID))
+
+	data.RewardCode = types.StringValue(reward.RewardCode)
+	data.EventTopic = types.StringPointerValue(reward.EventTopic)
+
+	rewardConditions := []AccelBytePlatformRewardConditionModel{}
+	for _, rewardCondition := range reward.RewardConditions {
+		rewardItems := []AccelBytePlatformRewardItemModel{}
+		for _, rewardItem := range rewardCondition.RewardItems {
+			item := AccelBytePlatformRewardItemModel{
+				ItemId:   types.StringNull(),
+				Sku:      types.StringNull(),
+				Quantity: types.Int32Value(rewardItem.Quantity),
+				Duration: fromApiPlatformItemOptionalInt32(rewardItem.Duration),
+				EndDate:  fromApiPlatformItemTimestamp(rewardItem.EndDate),
+			}
+			if rewardItem.IdentityType == platformclientmodels.RewardItemIdentityTypeITEMSKU {
+				item.Sku = types.StringValue(rewardItem.Sku)
+			} else {
+				item.ItemId = types.StringValue(rewardItem.ItemID)
+			}
+			rewardItems = append(rewardItems, item)
+		}
+
+		rewardItemsList, rewardItemsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelBytePlatformRewardItemModelAttributeTypes}, rewardItems)
+		diags.Append(rewardItemsDiags...)
+
+		rewardConditions = append(rewardConditions, AccelBytePlatformRewardConditionModel{
+			ConditionName: fromApiPlatformRewardOptionalString(rewardCondition.ConditionName),
+			Condition:     fromApiPlatformRewardOptionalString(rewardCondition.Condition),
+			EventName:     fromApiPlatformRewardOptionalString(rewardCondition.EventName),
+			RewardItems:   rewardItemsList,
+		})
+	}
+
+	rewardConditionsList, rewardConditionsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelBytePlatformRewardConditionModelAttributeTypes}, rewardConditions)
+	data.RewardConditions = rewardConditionsList
+	diags.Append(rewardConditionsDiags...)
+
+	data.Description = types.StringValue(reward.Description)
+	data.MaxAwarded = types.Int32Value(reward.MaxAwarded)
+	data.MaxAwardedPerUser = types.Int32Value(reward.MaxAwardedPerUser)
+	data.NamespaceExpression = types.StringValue(reward.NamespaceExpression)
+	data.UserIdExpression = types.StringValue(reward.UserIDExpression)
+
+	return diags
+}
+
+// Used by Create and Update operations on rewards.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// The create and update payloads are identical; the update payload is derived from this.
+func toApiPlatformRewardCreate(ctx context.Context, data AccelBytePlatformRewardModel) (*platformclientmodels.RewardCreate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	rewardConditions := []AccelBytePlatformRewardConditionModel{}
+	diags.Append(data.RewardConditions.ElementsAs(ctx, &rewardConditions, false)...)
+
+	apiRewardConditions := []*platformclientmodels.RewardCondition{}
+	for conditionIndex, rewardCondition := range rewardConditions {
+		rewardItems := []AccelBytePlatformRewardItemModel{}
+		diags.Append(rewardCondition.RewardItems.ElementsAs(ctx, &rewardItems, false)...)
+
+		apiRewardItems := []*platformclientmodels.RewardItem{}
+		for itemIndex, rewardItem := range rewardItems {
+			endDate, err := toApiPlatformItemTimestamp(rewardItem.EndDate)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("reward_conditions").AtListIndex(conditionIndex).AtName("reward_items").AtListIndex(itemIndex).AtName("end_date"),
+					"Invalid timestamp",
+					fmt.Sprintf("Unable to parse end date, got error: %s", err),
+				)
+			}
+
+			apiRewardItem := &platformclientmodels.RewardItem{
+				Quantity: rewardItem.Quantity.ValueInt32(),
+				Duration: rewardItem.Duration.ValueInt32(),
+				EndDate:  endDate,
+			}
+			if !rewardItem.Sku.IsNull() {
+				apiRewardItem.IdentityType = platformclientmodels.RewardItemIdentityTypeITEMSKU
+				apiRewardItem.Sku = rewardItem.Sku.ValueString()
+			} else {
+				apiRewardItem.IdentityType = platformclientmodels.RewardItemIdentityTypeITEMID
+				apiRewardItem.ItemID = rewardItem.ItemId.ValueString()
+			}
+			apiRewardItems = append(apiRewardItems, apiRewardItem)
+		}
+
+		apiRewardConditions = append(apiRewardConditions, &platformclientmodels.RewardCondition{
+			ConditionName: rewardCondition.ConditionName.ValueString(),
+			Condition:     rewardCondition.Condition.ValueString(),
+			EventName:     rewardCondition.EventName.ValueString(),
+			RewardItems:   apiRewardItems,
+		})
+	}
+
+	return &platformclientmodels.RewardCreate{
+		RewardCode:          data.RewardCode.ValueStringPointer(),
+		EventTopic:          data.EventTopic.ValueStringPointer(),
+		RewardConditions:    apiRewardConditions,
+		Description:         data.Description.ValueString(),
+		MaxAwarded:          data.MaxAwarded.ValueInt32(),
+		MaxAwardedPerUser:   data.MaxAwardedPerUser.ValueInt32(),
+		NamespaceExpression: data.NamespaceExpression.ValueString(),
+		UserIDExpression:    data.UserIdExpression.ValueString(),
+	}, diags
+}
+
+// Used by the Update operation on rewards.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformRewardUpdate(ctx context.Context, data AccelBytePlatformRewardModel) (*platformclientmodels.RewardUpdate, diag.Diagnostics) {
+
+	apiReward, diags := toApiPlatformRewardCreate(ctx, data)
+
+	return &platformclientmodels.RewardUpdate{
+		RewardCode:          apiReward.RewardCode,
+		EventTopic:          apiReward.EventTopic,
+		RewardConditions:    apiReward.RewardConditions,
+		Description:         apiReward.Description,
+		MaxAwarded:          apiReward.MaxAwarded,
+		MaxAwardedPerUser:   apiReward.MaxAwardedPerUser,
+		NamespaceExpression: apiReward.NamespaceExpression,
+		UserIDExpression:    apiReward.UserIDExpression,
+	}, diags
+}
+
+func computePlatformRewardId(namespace string, rewardId string) string {
+	return fmt.Sprintf("%s/%s", namespace, rewardId)
+}
@@ -0,0 +1,430 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/reward"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformRewardResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformRewardResource{}
+var _ resource.ResourceWithValidateConfig = &AccelBytePlatformRewardResource{}
+
+func NewAccelBytePlatformRewardResource() resource.Resource {
+	return &AccelBytePlatformRewardResource{}
+}
+
+// AccelBytePlatformRewardResource defines the resource implementation.
+type AccelBytePlatformRewardResource struct {
+	client *platform.RewardService
+}
+
+func (r *AccelBytePlatformRewardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_reward"
+}
+
+func (r *AccelBytePlatformRewardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [reward](https://docs.accelbyte.io/gaming-services/services/monetization/rewards/). A reward listens to events on a topic, such as statistic updates, and grants items to a player when an event matches one of its conditions.\n\nRewards grant items only. To grant virtual currency, grant a `COINS` item which is backed by that currency.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the reward. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"reward_id": schema.StringAttribute{
+				MarkdownDescription: "Reward ID, as assigned by the Platform service when the reward was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Reward identifier, on the format `{{namespace}}/{{reward_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"reward_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the reward. Must be unique within the namespace.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"event_topic": schema.StringAttribute{
+				MarkdownDescription: "Topic of the events which the reward listens to, for example `statistic`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"reward_conditions": schema.ListNestedAttribute{
+				MarkdownDescription: "Conditions which are evaluated against each event on the topic. Each condition whose criteria match the event grants its reward items.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"condition_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the condition. Must be unique within the reward. The Platform service records which named conditions a player has been rewarded for, so that each is only granted once.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"condition": schema.StringAttribute{
+							MarkdownDescription: "JSONPath expression which the event must match, for example `$[?(@.statCode == 'kills' && @.latestValue >= 100)]`. If not set, every event with a matching `event_name` satisfies the condition.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^\$`), "must be a JSONPath expression starting with `$`"),
+							},
+						},
+						"event_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the event which the condition applies to, for example `statItemUpdated`. If not set, the condition applies to all events on the topic.",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"reward_items": schema.ListNestedAttribute{
+							MarkdownDescription: "Items which are granted when the condition matches.",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"item_id": schema.StringAttribute{
+										MarkdownDescription: "ID of the item to grant. Exactly one of `item_id` and `sku` must be set.",
+										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("sku")),
+										},
+									},
+									"sku": schema.StringAttribute{
+										MarkdownDescription: "SKU of the item to grant. Exactly one of `item_id` and `sku` must be set.",
+										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtLeast(1),
+										},
+									},
+									"quantity": schema.Int32Attribute{
+										MarkdownDescription: "Number of items to grant.",
+										Required:            true,
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+									"duration": schema.Int32Attribute{
+										MarkdownDescription: "Number of days for which the granted entitlement is valid. If not set, the entitlement does not expire after a duration.",
+										Optional:            true,
+										Validators: []validator.Int32{
+											int32validator.AtLeast(1),
+										},
+									},
+									"end_date": schema.StringAttribute{
+										MarkdownDescription: "Time at which the granted entitlement expires, for example `2025-01-01T00:00:00Z`. Must be in UTC.",
+										Optional:            true,
+										Validators: []validator.String{
+											platformItemTimestampValidator,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the reward.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"max_awarded": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of times the reward can be granted in total. `0` means no limit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"max_awarded_per_user": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of times the reward can be granted to each player. `0` means no limit.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int32default.StaticInt32(0),
+				Validators: []validator.Int32{
+					int32validator.AtLeast(0),
+				},
+			},
+			"namespace_expression": schema.StringAttribute{
+				MarkdownDescription: "JSONPath expression which extracts the namespace from the event. If empty, the namespace of the event is used.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"user_id_expression": schema.StringAttribute{
+				MarkdownDescription: "JSONPath expression which extracts the ID of the player to reward from the event. If empty, the user ID of the event is used.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformRewardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelBytePlatformRewardModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.RewardConditions.IsNull() || data.RewardConditions.IsUnknown() {
+		return
+	}
+
+	rewardConditions := []AccelBytePlatformRewardConditionModel{}
+	resp.Diagnostics.Append(data.RewardConditions.ElementsAs(ctx, &rewardConditions, false)...)
+
+	conditionNames := map[string]bool{}
+	for index, rewardCondition := range rewardConditions {
+		if rewardCondition.ConditionName.IsNull() || rewardCondition.ConditionName.IsUnknown() {
+			continue
+		}
+		conditionName := rewardCondition.ConditionName.ValueString()
+		if conditionNames[conditionName] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("reward_conditions").AtListIndex(index).AtName("condition_name"),
+				"Duplicate reward condition",
+				fmt.Sprintf("Attribute `reward_conditions` contains more than one condition named '%s'", conditionName),
+			)
+		}
+		conditionNames[conditionName] = true
+	}
+}
+
+func (r *AccelBytePlatformRewardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformRewardService
+}
+
+func (r *AccelBytePlatformRewardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformRewardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReward, apiRewardDiags := toApiPlatformRewardCreate(ctx, data)
+	resp.Diagnostics.Append(apiRewardDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating reward via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"rewardCode": data.RewardCode,
+	})
+
+	input := &reward.CreateRewardParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiReward,
+	}
+
+	createdReward, err := r.client.CreateRewardShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating reward via AccelByte API", fmt.Sprintf("Unable to create reward '%s' in namespace '%s', got error: %s", data.RewardCode.ValueString(), input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformReward(ctx, &data, createdReward)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformRewardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformRewardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &reward.GetRewardParams{
+		Namespace: data.Namespace.ValueString(),
+		RewardID:  data.RewardId.ValueString(),
+	}
+	apiReward, err := r.client.GetRewardShort(input)
+	if err != nil {
+		notFoundError := &reward.GetRewardNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading reward via AccelByte API", fmt.Sprintf("Unable to read reward '%s' in namespace '%s', got error: %s", input.RewardID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read reward from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"rewardId":  data.RewardId,
+		"reward":    apiReward,
+	})
+
+	resp.Diagnostics.Append(updateFromApiPlatformReward(ctx, &data, apiReward)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformRewardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformRewardModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReward, apiRewardDiags := toApiPlatformRewardUpdate(ctx, data)
+	resp.Diagnostics.Append(apiRewardDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating reward via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"rewardId":  data.RewardId,
+	})
+
+	input := &reward.UpdateRewardParams{
+		Namespace: data.Namespace.ValueString(),
+		RewardID:  data.RewardId.ValueString(),
+		Body:      apiReward,
+	}
+
+	updatedReward, err := r.client.UpdateRewardShort(input)
+	if err != nil {
+		notFoundError := &reward.UpdateRewardNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Reward '%s' does not exist in namespace '%s'", input.RewardID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating reward via AccelByte API", fmt.Sprintf("Unable to update reward '%s' in namespace '%s', got error: %s", input.RewardID, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformReward(ctx, &data, updatedReward)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformRewardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformRewardModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting reward via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"rewardId":  data.RewardId,
+	})
+
+	input := &reward.DeleteRewardParams{
+		Namespace: data.Namespace.ValueString(),
+		RewardID:  data.RewardId.ValueString(),
+	}
+	_, err := r.client.DeleteRewardShort(input)
+	if err != nil {
+		notFoundError := &reward.DeleteRewardNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting reward via AccelByte API", fmt.Sprintf("Unable to delete reward '%s' in namespace '%s', got error: %s", input.RewardID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformRewardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "reward_id")
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformStoreModel is used by AccelBytePlatformStoreResource.
+type AccelBytePlatformStoreModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	StoreId types.String `tfsdk:"store_id"`
+	Id      types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Title types.String `tfsdk:"title"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or the API
+	Description        types.String `tfsdk:"description"`
+	DefaultLanguage    types.String `tfsdk:"default_language"`
+	DefaultRegion      types.String `tfsdk:"default_region"`
+	SupportedLanguages types.Set    `tfsdk:"supported_languages"` // []string
+	SupportedRegions   types.Set    `tfsdk:"supported_regions"`   // []string
+
+	// Computed during Read() operation
+	Published types.Bool `tfsdk:"published"`
+}
+
+// Used by Create, Read and Update operations on stores.
+// This copies data from the AccelByte API `store` to the TF state `data`.
+func updateFromApiPlatformStore(ctx context.Context, data *AccelBytePlatformStoreModel, store *platformclientmodels.StoreInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*store.Namespace)
+	data.StoreId = types.StringValue(*store.StoreID)
+	data.Id = types.StringValue(computePlatformStoreId(*store.Namespace, *store.StoreID))
+
+	data.Title = types.StringPointerValue(store.Title)
+
+	data.Description = types.StringValue(store.Description)
+	data.DefaultLanguage = types.StringPointerValue(store.DefaultLanguage)
+	data.DefaultRegion = types.StringPointerValue(store.DefaultRegion)
+
+	supportedLanguages, supportedLanguagesDiags := setValueFromEvenIfNil(ctx, types.StringType, store.SupportedLanguages)
+	data.SupportedLanguages = supportedLanguages
+	diags.Append(supportedLanguagesDiags...)
+
+	supportedRegions, supportedRegionsDiags := setValueFromEvenIfNil(ctx, types.StringType, store.SupportedRegions)
+	data.SupportedRegions = supportedRegions
+	diags.Append(supportedRegionsDiags...)
+
+	data.Published = types.BoolValue(store.Published != nil && *store.Published)
+
+	return diags
+}
+
+// Used by the Create operation on stores.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Unknown values are left empty, so that the API fills in its defaults.
+func toApiPlatformStoreCreate(ctx context.Context, data AccelBytePlatformStoreModel) (*platformclientmodels.StoreCreate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	supportedLanguages := []string{}
+	if !data.SupportedLanguages.IsUnknown() {
+		diags.Append(data.SupportedLanguages.ElementsAs(ctx, &supportedLanguages, false)...)
+	}
+
+	supportedRegions := []string{}
+	if !data.SupportedRegions.IsUnknown() {
+		diags.Append(data.SupportedRegions.ElementsAs(ctx, &supportedRegions, false)...)
+	}
+
+	return &platformclientmodels.StoreCreate{
+		Title:              data.Title.ValueStringPointer(),
+		Description:        data.Description.ValueString(),
+		DefaultLanguage:    data.DefaultLanguage.ValueString(),
+		DefaultRegion:      data.DefaultRegion.ValueString(),
+		SupportedLanguages: supportedLanguages,
+		SupportedRegions:   supportedRegions,
+	}, diags
+}
+
+// Used by the Update operation on stores.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiPlatformStoreUpdate(ctx context.Context, data AccelBytePlatformStoreModel) (*platformclientmodels.StoreUpdate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	supportedLanguages := []string{}
+	if !data.SupportedLanguages.IsUnknown() {
+		diags.Append(data.SupportedLanguages.ElementsAs(ctx, &supportedLanguages, false)...)
+	}
+
+	supportedRegions := []string{}
+	if !data.SupportedRegions.IsUnknown() {
+		diags.Append(data.SupportedRegions.ElementsAs(ctx, &supportedRegions, false)...)
+	}
+
+	return &platformclientmodels.StoreUpdate{
+		Title:              data.Title.ValueStringPointer(),
+		Description:        data.Description.ValueString(),
+		DefaultLanguage:    data.DefaultLanguage.ValueString(),
+		DefaultRegion:      data.DefaultRegion.ValueString(),
+		SupportedLanguages: supportedLanguages,
+		SupportedRegions:   supportedRegions,
+	}, diags
+}
+
+func computePlatformStoreId(namespace string, storeId string) string {
+	return fmt.Sprintf("%s/%s", namespace, storeId)
+}
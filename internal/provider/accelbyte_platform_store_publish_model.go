@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelBytePlatformStorePublishModel is used by AccelBytePlatformStorePublishResource.
+type AccelBytePlatformStorePublishModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	StoreId   types.String `tfsdk:"store_id"`
+	Triggers  types.Map    `tfsdk:"triggers"` // map[string]string
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/catalog_changes"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformStorePublishResource{}
+
+func NewAccelBytePlatformStorePublishResource() resource.Resource {
+	return &AccelBytePlatformStorePublishResource{}
+}
+
+// AccelBytePlatformStorePublishResource defines the resource implementation.
+type AccelBytePlatformStorePublishResource struct {
+	client *platform.CatalogChangesService
+}
+
+func (r *AccelBytePlatformStorePublishResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_store_publish"
+}
+
+func (r *AccelBytePlatformStorePublishResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource publishes all pending changes in a draft [store](https://docs.accelbyte.io/gaming-services/services/monetization/store/), making them visible to players. The draft store is published when the resource is created, and again whenever any of its attributes change. Use `triggers` to publish whenever the store's contents change.\n\nDestroying this resource does not unpublish anything.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; changing any of these publishes the store again
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the store. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the draft store to publish.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values which, when changed, cause the draft store to be published again. For example, these can refer to the IDs of the store's categories and items.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier, on the format `{{namespace}}/{{store_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformStorePublishResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformCatalogChangesService
+}
+
+func (r *AccelBytePlatformStorePublishResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformStorePublishModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Publishing store via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+	})
+
+	input := &catalog_changes.PublishAllParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueString(),
+	}
+
+	_, err := r.client.PublishAllShort(input)
+	if err != nil {
+		notFoundError := &catalog_changes.PublishAllNotFound{}
+		if errors.As(err, &notFoundError) {
+			resp.Diagnostics.AddError("Store not found", fmt.Sprintf("Store '%s' does not exist in namespace '%s'", input.StoreID, input.Namespace))
+			return
+		}
+		resp.Diagnostics.AddError("Error when publishing store via AccelByte API", fmt.Sprintf("Unable to publish store '%s' in namespace '%s', got error: %s", input.StoreID, input.Namespace, err))
+		return
+	}
+
+	data.Id = types.StringValue(computePlatformStoreId(data.Namespace.ValueString(), data.StoreId.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformStorePublishResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Publishing is an action, not an object in the AccelByte backend; there is nothing to refresh
+}
+
+func (r *AccelBytePlatformStorePublishResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformStorePublishModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All user-settable attributes force replacement, which publishes the store again; nothing needs to be done here
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformStorePublishResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The published store is left as-is; removing the resource only removes it from the Terraform state
+}
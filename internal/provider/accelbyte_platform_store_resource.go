@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/platform-sdk/pkg/platformclient/store"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelBytePlatformStoreResource{}
+var _ resource.ResourceWithImportState = &AccelBytePlatformStoreResource{}
+
+func NewAccelBytePlatformStoreResource() resource.Resource {
+	return &AccelBytePlatformStoreResource{}
+}
+
+// AccelBytePlatformStoreResource defines the resource implementation.
+type AccelBytePlatformStoreResource struct {
+	client *platform.StoreService
+}
+
+func (r *AccelBytePlatformStoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_platform_store"
+}
+
+func (r *AccelBytePlatformStoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [store](https://docs.accelbyte.io/gaming-services/services/monetization/store/). A namespace normally has one draft store, which is edited, and one published store, which players see. Changes to the draft store become visible to players when the draft store is published; see the `accelbyte_platform_store_publish` resource.\n\nThe published store can be imported as well, by using its store ID.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the store. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"store_id": schema.StringAttribute{
+				MarkdownDescription: "Store ID, as assigned by the Platform service when the store was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Store identifier, on the format `{{namespace}}/{{store_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the store. Must start and end with a letter or a digit. Max 127 characters in length.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 127),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema or from the API
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the store. The Platform service does not support clearing the description once it has been set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"default_language": schema.StringAttribute{
+				MarkdownDescription: "Default language of the store, as a BCP 47 language tag. Default: `en`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("en"),
+			},
+			"default_region": schema.StringAttribute{
+				MarkdownDescription: "Default region of the store, as an ISO 3166-1 alpha-2 country code. Default: `US`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("US"),
+			},
+			"supported_languages": schema.SetAttribute{
+				MarkdownDescription: "Languages supported by the store, as BCP 47 language tags. If not set, the Platform service decides which languages are supported.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"supported_regions": schema.SetAttribute{
+				MarkdownDescription: "Regions supported by the store, as ISO 3166-1 alpha-2 country codes. If not set, the Platform service decides which regions are supported.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"published": schema.BoolAttribute{
+				MarkdownDescription: "If `true`, this is the namespace's published store. If `false`, this is a draft store.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelBytePlatformStoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.PlatformStoreService
+}
+
+func (r *AccelBytePlatformStoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelBytePlatformStoreModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiStore, apiStoreDiags := toApiPlatformStoreCreate(ctx, data)
+	resp.Diagnostics.Append(apiStoreDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating store via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"title":     data.Title,
+	})
+
+	input := &store.CreateStoreParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiStore,
+	}
+
+	createdStore, err := r.client.CreateStoreShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating store via AccelByte API", fmt.Sprintf("Unable to create store '%s' in namespace '%s', got error: %s", data.Title.ValueString(), input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformStore(ctx, &data, createdStore)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformStoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelBytePlatformStoreModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &store.GetStoreParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueString(),
+	}
+	apiStore, err := r.client.GetStoreShort(input)
+	if err != nil {
+		notFoundError := &store.GetStoreNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading store via AccelByte API", fmt.Sprintf("Unable to read store '%s' in namespace '%s', got error: %s", input.StoreID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read store from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+		"store":     apiStore,
+	})
+
+	resp.Diagnostics.Append(updateFromApiPlatformStore(ctx, &data, apiStore)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformStoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelBytePlatformStoreModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiStore, apiStoreDiags := toApiPlatformStoreUpdate(ctx, data)
+	resp.Diagnostics.Append(apiStoreDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating store via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+	})
+
+	input := &store.UpdateStoreParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueString(),
+		Body:      apiStore,
+	}
+
+	updatedStore, err := r.client.UpdateStoreShort(input)
+	if err != nil {
+		notFoundError := &store.UpdateStoreNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Store '%s' does not exist in namespace '%s'", input.StoreID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating store via AccelByte API", fmt.Sprintf("Unable to update store '%s' in namespace '%s', got error: %s", input.StoreID, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiPlatformStore(ctx, &data, updatedStore)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelBytePlatformStoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelBytePlatformStoreModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting store via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"storeId":   data.StoreId,
+		"published": data.Published,
+	})
+
+	// The published store is deleted via its own API, which does not take a store ID
+	if data.Published.ValueBool() {
+		input := &store.DeletePublishedStoreParams{
+			Namespace: data.Namespace.ValueString(),
+		}
+		_, err := r.client.DeletePublishedStoreShort(input)
+		if err != nil {
+			notFoundError := &store.DeletePublishedStoreNotFound{}
+			if errors.As(err, &notFoundError) {
+				// The resource has already been deleted from the AccelByte backend
+				return
+			}
+			resp.Diagnostics.AddError("Error when deleting published store via AccelByte API", fmt.Sprintf("Unable to delete published store in namespace '%s', got error: %s", input.Namespace, err))
+		}
+		return
+	}
+
+	input := &store.DeleteStoreParams{
+		Namespace: data.Namespace.ValueString(),
+		StoreID:   data.StoreId.ValueString(),
+	}
+	_, err := r.client.DeleteStoreShort(input)
+	if err != nil {
+		notFoundError := &store.DeleteStoreNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting store via AccelByte API", fmt.Sprintf("Unable to delete store '%s' in namespace '%s', got error: %s", input.StoreID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelBytePlatformStoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "store_id")
+}
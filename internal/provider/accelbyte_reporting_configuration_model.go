@@ -0,0 +1,278 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// AccelByteReportingConfigurationModel is used by AccelByteReportingConfigurationResource.
+type AccelByteReportingConfigurationModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	TimeIntervalSeconds          types.Int64 `tfsdk:"time_interval_seconds"`
+	UserMaxReportPerTimeInterval types.Int32 `tfsdk:"user_max_report_per_time_interval"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	CategoryLimits  types.List `tfsdk:"category_limits"`  // []AccelByteReportingCategoryLimitModel
+	ModerationRules types.List `tfsdk:"moderation_rules"` // []AccelByteReportingModerationRuleModel
+}
+
+var AccelByteReportingCategoryLimitModelAttributeTypes = map[string]attr.Type{
+	"category":              types.StringType,
+	"extension_category":    types.StringType,
+	"max_report_per_ticket": types.Int32Type,
+}
+
+type AccelByteReportingCategoryLimitModel struct {
+	Category           types.String `tfsdk:"category"`
+	ExtensionCategory  types.String `tfsdk:"extension_category"`
+	MaxReportPerTicket types.Int32  `tfsdk:"max_report_per_ticket"`
+}
+
+var AccelByteReportingBanAccountModelAttributeTypes = map[string]attr.Type{
+	"type":              types.StringType,
+	"duration_minutes":  types.Int32Type,
+	"reason":            types.StringType,
+	"comment":           types.StringType,
+	"skip_notification": types.BoolType,
+}
+
+type AccelByteReportingBanAccountModel struct {
+	Type             types.String `tfsdk:"type"`
+	DurationMinutes  types.Int32  `tfsdk:"duration_minutes"`
+	Reason           types.String `tfsdk:"reason"`
+	Comment          types.String `tfsdk:"comment"`
+	SkipNotification types.Bool   `tfsdk:"skip_notification"`
+}
+
+var AccelByteReportingModerationRuleModelAttributeTypes = map[string]attr.Type{
+	"category":             types.StringType,
+	"extension_category":   types.StringType,
+	"reason":               types.StringType,
+	"threshold":            types.Int32Type,
+	"active":               types.BoolType,
+	"hide_content":         types.BoolType,
+	"delete_chat":          types.BoolType,
+	"ban_account":          types.ObjectType{AttrTypes: AccelByteReportingBanAccountModelAttributeTypes},
+	"extension_action_ids": types.SetType{ElemType: types.StringType},
+}
+
+type AccelByteReportingModerationRuleModel struct {
+	Category           types.String `tfsdk:"category"`
+	ExtensionCategory  types.String `tfsdk:"extension_category"`
+	Reason             types.String `tfsdk:"reason"`
+	Threshold          types.Int32  `tfsdk:"threshold"`
+	Active             types.Bool   `tfsdk:"active"`
+	HideContent        types.Bool   `tfsdk:"hide_content"`
+	DeleteChat         types.Bool   `tfsdk:"delete_chat"`
+	BanAccount         types.Object `tfsdk:"ban_account"`          // AccelByteReportingBanAccountModel
+	ExtensionActionIds types.Set    `tfsdk:"extension_action_ids"` // []string
+}
+
+// Used by Create, Read and Update operations on the reporting configuration.
+// This copies data from the AccelByte API `config` and `moderationRules` to the TF state `data`.
+// Moderation rules which are already present in `data` keep their order; any other rules are appended at the end.
+func updateFromApiReportingConfiguration(ctx context.Context, data *AccelByteReportingConfigurationModel, config *reportingclientmodels.RestapiConfigResponse, moderationRules []*reportingclientmodels.RestapiModerationRuleResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Id = types.StringValue(data.Namespace.ValueString())
+
+	reportingLimit := config.ReportingLimit
+	if reportingLimit == nil {
+		reportingLimit = &reportingclientmodels.RestapiReportingLimit{}
+	}
+
+	if reportingLimit.TimeInterval != nil {
+		data.TimeIntervalSeconds = types.Int64Value(*reportingLimit.TimeInterval / int64(time.Second))
+	} else {
+		data.TimeIntervalSeconds = types.Int64Null()
+	}
+	data.UserMaxReportPerTimeInterval = types.Int32PointerValue(reportingLimit.UserMaxReportPerTimeInterval)
+
+	categoryLimits := []AccelByteReportingCategoryLimitModel{}
+	for _, categoryLimit := range reportingLimit.CategoryLimits {
+		categoryLimits = append(categoryLimits, AccelByteReportingCategoryLimitModel{
+			Category:           types.StringPointerValue(categoryLimit.Name),
+			ExtensionCategory:  types.StringValue(categoryLimit.ExtensionCategory),
+			MaxReportPerTicket: types.Int32PointerValue(categoryLimit.MaxReportPerTicket),
+		})
+	}
+
+	categoryLimitsList, categoryLimitsDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteReportingCategoryLimitModelAttributeTypes}, categoryLimits)
+	data.CategoryLimits = categoryLimitsList
+	diags.Append(categoryLimitsDiags...)
+
+	// Determine the order of the rules in the previous TF state
+	ruleOrder := map[string]int{}
+	if !data.ModerationRules.IsNull() && !data.ModerationRules.IsUnknown() {
+		previousRules := []AccelByteReportingModerationRuleModel{}
+		diags.Append(data.ModerationRules.ElementsAs(ctx, &previousRules, false)...)
+		for index, previousRule := range previousRules {
+			ruleOrder[reportingModerationRuleKey(previousRule.Category.ValueString(), previousRule.ExtensionCategory.ValueString(), previousRule.Reason.ValueString())] = index
+		}
+	}
+
+	orderedRules := make([]*AccelByteReportingModerationRuleModel, len(ruleOrder))
+	extraRules := []*AccelByteReportingModerationRuleModel{}
+	for _, moderationRule := range moderationRules {
+		rule, ruleDiags := fromApiReportingModerationRule(ctx, moderationRule)
+		diags.Append(ruleDiags...)
+
+		if index, ok := ruleOrder[reportingModerationRuleKey(*moderationRule.Category, moderationRule.ExtensionCategory, *moderationRule.Reason)]; ok {
+			orderedRules[index] = rule
+		} else {
+			extraRules = append(extraRules, rule)
+		}
+	}
+
+	rules := []AccelByteReportingModerationRuleModel{}
+	for _, rule := range append(orderedRules, extraRules...) {
+		// Rules which have been removed from the AccelByte backend are absent
+		if rule != nil {
+			rules = append(rules, *rule)
+		}
+	}
+
+	moderationRulesList, moderationRulesDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteReportingModerationRuleModelAttributeTypes}, rules)
+	data.ModerationRules = moderationRulesList
+	diags.Append(moderationRulesDiags...)
+
+	return diags
+}
+
+// This copies a moderation rule from the AccelByte API to a TF state object.
+func fromApiReportingModerationRule(ctx context.Context, moderationRule *reportingclientmodels.RestapiModerationRuleResponse) (*AccelByteReportingModerationRuleModel, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	actions := moderationRule.Actions
+	if actions == nil {
+		actions = &reportingclientmodels.RestapiModerationRuleActionsResponse{}
+	}
+
+	banAccount := types.ObjectNull(AccelByteReportingBanAccountModelAttributeTypes)
+	if actions.BanAccount != nil {
+		banAccountObject, banAccountDiags := basetypes.NewObjectValueFrom(ctx, AccelByteReportingBanAccountModelAttributeTypes, AccelByteReportingBanAccountModel{
+			Type:             types.StringPointerValue(actions.BanAccount.Type),
+			DurationMinutes:  types.Int32PointerValue(actions.BanAccount.Duration),
+			Reason:           types.StringPointerValue(actions.BanAccount.Reason),
+			Comment:          types.StringValue(actions.BanAccount.Comment),
+			SkipNotification: types.BoolPointerValue(actions.BanAccount.SkipNotif),
+		})
+		banAccount = banAccountObject
+		diags.Append(banAccountDiags...)
+	}
+
+	extensionActionIds := actions.ExtensionActionIds
+	if extensionActionIds == nil {
+		extensionActionIds = []string{}
+	}
+	extensionActionIdsSet, extensionActionIdsDiags := types.SetValueFrom(ctx, types.StringType, extensionActionIds)
+	diags.Append(extensionActionIdsDiags...)
+
+	return &AccelByteReportingModerationRuleModel{
+		Category:           types.StringPointerValue(moderationRule.Category),
+		ExtensionCategory:  types.StringValue(moderationRule.ExtensionCategory),
+		Reason:             types.StringPointerValue(moderationRule.Reason),
+		Threshold:          types.Int32PointerValue(moderationRule.Threshold),
+		Active:             types.BoolPointerValue(moderationRule.Active),
+		HideContent:        types.BoolValue(actions.HideContent),
+		DeleteChat:         types.BoolValue(actions.DeleteChat),
+		BanAccount:         banAccount,
+		ExtensionActionIds: extensionActionIdsSet,
+	}, diags
+}
+
+// Used by Create and Update operations on the reporting configuration.
+// This reads from the TF state `data` and returns the AccelByte API reporting limits.
+func toApiReportingLimit(ctx context.Context, data AccelByteReportingConfigurationModel) (*reportingclientmodels.RestapiReportingLimit, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	categoryLimits := []AccelByteReportingCategoryLimitModel{}
+	diags.Append(data.CategoryLimits.ElementsAs(ctx, &categoryLimits, false)...)
+
+	apiCategoryLimits := []*reportingclientmodels.RestapiCategoryLimit{}
+	for _, categoryLimit := range categoryLimits {
+		apiCategoryLimits = append(apiCategoryLimits, &reportingclientmodels.RestapiCategoryLimit{
+			Name:               categoryLimit.Category.ValueStringPointer(),
+			ExtensionCategory:  categoryLimit.ExtensionCategory.ValueString(),
+			MaxReportPerTicket: categoryLimit.MaxReportPerTicket.ValueInt32Pointer(),
+		})
+	}
+
+	timeInterval := data.TimeIntervalSeconds.ValueInt64() * int64(time.Second)
+
+	return &reportingclientmodels.RestapiReportingLimit{
+		TimeInterval:                 &timeInterval,
+		UserMaxReportPerTimeInterval: data.UserMaxReportPerTimeInterval.ValueInt32Pointer(),
+		CategoryLimits:               apiCategoryLimits,
+	}, diags
+}
+
+// Used by Create and Update operations on the reporting configuration.
+// This reads from the TF state `data` and returns the AccelByte API moderation rules, in the same order as in `data`.
+func toApiReportingModerationRules(ctx context.Context, data AccelByteReportingConfigurationModel) ([]*reportingclientmodels.RestapiModerationRuleRequest, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	rules := []AccelByteReportingModerationRuleModel{}
+	diags.Append(data.ModerationRules.ElementsAs(ctx, &rules, false)...)
+
+	apiRules := []*reportingclientmodels.RestapiModerationRuleRequest{}
+	for _, rule := range rules {
+		extensionActionIds := []string{}
+		diags.Append(rule.ExtensionActionIds.ElementsAs(ctx, &extensionActionIds, false)...)
+
+		var apiBanAccount *reportingclientmodels.RestapiBanAccountActionRequest = nil
+		if !rule.BanAccount.IsNull() && !rule.BanAccount.IsUnknown() {
+			var banAccount AccelByteReportingBanAccountModel
+			diags.Append(rule.BanAccount.As(ctx, &banAccount, basetypes.ObjectAsOptions{})...)
+
+			apiBanAccount = &reportingclientmodels.RestapiBanAccountActionRequest{
+				Type:      banAccount.Type.ValueStringPointer(),
+				Duration:  banAccount.DurationMinutes.ValueInt32Pointer(),
+				Reason:    banAccount.Reason.ValueStringPointer(),
+				Comment:   banAccount.Comment.ValueString(),
+				SkipNotif: banAccount.SkipNotification.ValueBoolPointer(),
+			}
+		}
+
+		apiRules = append(apiRules, &reportingclientmodels.RestapiModerationRuleRequest{
+			Category:          rule.Category.ValueStringPointer(),
+			ExtensionCategory: rule.ExtensionCategory.ValueString(),
+			Reason:            rule.Reason.ValueStringPointer(),
+			Threshold:         rule.Threshold.ValueInt32Pointer(),
+			Active:            rule.Active.ValueBoolPointer(),
+			Actions: &reportingclientmodels.RestapiModerationRuleActionsRequest{
+				HideContent:        rule.HideContent.ValueBool(),
+				DeleteChat:         rule.DeleteChat.ValueBool(),
+				BanAccount:         apiBanAccount,
+				ExtensionActionIds: extensionActionIds,
+			},
+		})
+	}
+
+	return apiRules, diags
+}
+
+// Moderation rules are identified by their category, extension category and reason; the Reporting service allows only one rule per combination.
+func reportingModerationRuleKey(category string, extensionCategory string, reason string) string {
+	return fmt.Sprintf("%s/%s/%s", category, extensionCategory, reason)
+}
@@ -0,0 +1,539 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclient/admin_configurations"
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclient/admin_moderation_rule"
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/reporting"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteReportingConfigurationResource{}
+var _ resource.ResourceWithImportState = &AccelByteReportingConfigurationResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteReportingConfigurationResource{}
+
+const reportingModerationRulesPageSize = 100
+
+var reportingCategories = []string{
+	reportingclientmodels.RestapiModerationRuleRequestCategoryCHAT,
+	reportingclientmodels.RestapiModerationRuleRequestCategoryEXTENSION,
+	reportingclientmodels.RestapiModerationRuleRequestCategoryUGC,
+	reportingclientmodels.RestapiModerationRuleRequestCategoryUSER,
+}
+
+func NewAccelByteReportingConfigurationResource() resource.Resource {
+	return &AccelByteReportingConfigurationResource{}
+}
+
+// AccelByteReportingConfigurationResource defines the resource implementation.
+type AccelByteReportingConfigurationResource struct {
+	client               *reporting.AdminConfigurationsService
+	moderationRuleClient *reporting.AdminModerationRuleService
+}
+
+func (r *AccelByteReportingConfigurationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reporting_configuration"
+}
+
+func (r *AccelByteReportingConfigurationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the reporting configuration of a namespace: how often players may report, and the auto-moderation rules which act on content or players once enough reports have been made. There is exactly one reporting configuration per namespace; use at most one of these resources per namespace.\n\nThis resource manages all moderation rules in the namespace. Rules which are not listed in `moderation_rules` are deleted.\n\nDestroying the resource deletes all moderation rules. The reporting limits cannot be deleted, and retain their current values in the AccelByte backend.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace whose reporting is configured. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Reporting configuration identifier, on the format `{{namespace}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"time_interval_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Length of the time window, in seconds, over which `user_max_report_per_time_interval` applies. Must be between 1 and 6000000.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 6000000),
+				},
+			},
+			"user_max_report_per_time_interval": schema.Int32Attribute{
+				MarkdownDescription: "Maximum number of reports which a player can submit within the time window.",
+				Required:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"category_limits": schema.ListNestedAttribute{
+				MarkdownDescription: "Per-category limits on the number of reports which a single ticket can collect.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(basetypes.NewListValueMust(types.ObjectType{AttrTypes: AccelByteReportingCategoryLimitModelAttributeTypes}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Report category. One of `CHAT`, `EXTENSION`, `UGC` or `USER`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(reportingCategories...),
+							},
+						},
+						"extension_category": schema.StringAttribute{
+							MarkdownDescription: "Extension category, for reports on content from custom services. Only applies when `category` is `EXTENSION`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"max_report_per_ticket": schema.Int32Attribute{
+							MarkdownDescription: "Maximum number of reports which a single ticket in this category can collect.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"moderation_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Auto-moderation rules. A rule triggers its actions once the number of reports with the given category and reason reaches the rule's threshold. There can be at most one rule for each combination of `category`, `extension_category` and `reason`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(basetypes.NewListValueMust(types.ObjectType{AttrTypes: AccelByteReportingModerationRuleModelAttributeTypes}, []attr.Value{})),
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Report category which the rule applies to. One of `CHAT`, `EXTENSION`, `UGC` or `USER`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(reportingCategories...),
+							},
+						},
+						"extension_category": schema.StringAttribute{
+							MarkdownDescription: "Extension category which the rule applies to. Only applies when `category` is `EXTENSION`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(""),
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "Title of the report reason which the rule applies to. This is typically taken from the `title` attribute of an `accelbyte_reporting_reason` resource.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 128),
+							},
+						},
+						"threshold": schema.Int32Attribute{
+							MarkdownDescription: "Number of reports which triggers the rule's actions.",
+							Required:            true,
+							Validators: []validator.Int32{
+								int32validator.AtLeast(1),
+							},
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "If set to `false`, the rule is kept but does not trigger.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"hide_content": schema.BoolAttribute{
+							MarkdownDescription: "If set to `true`, the reported content is hidden when the rule triggers.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"delete_chat": schema.BoolAttribute{
+							MarkdownDescription: "If set to `true`, the reported chat message is deleted when the rule triggers. Only applies when `category` is `CHAT`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"ban_account": schema.SingleNestedAttribute{
+							MarkdownDescription: "If set, the reported player is banned when the rule triggers.",
+							Optional:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "IAM ban type, for example `CHAT_SEND` or `LOGIN`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"duration_minutes": schema.Int32Attribute{
+									MarkdownDescription: "Duration of the ban, in minutes. `-1` means that the ban does not expire.",
+									Required:            true,
+									Validators: []validator.Int32{
+										int32validator.Any(
+											int32validator.OneOf(-1),
+											int32validator.AtLeast(1),
+										),
+									},
+								},
+								"reason": schema.StringAttribute{
+									MarkdownDescription: "IAM ban reason, for example `HARASSMENT` or `HATE_SPEECH`.",
+									Required:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtLeast(1),
+									},
+								},
+								"comment": schema.StringAttribute{
+									MarkdownDescription: "Comment which is stored with the ban.",
+									Optional:            true,
+									Computed:            true,
+									Default:             stringdefault.StaticString(""),
+								},
+								"skip_notification": schema.BoolAttribute{
+									MarkdownDescription: "If set to `true`, the banned player is not notified, for example by email.",
+									Optional:            true,
+									Computed:            true,
+									Default:             booldefault.StaticBool(false),
+								},
+							},
+						},
+						"extension_action_ids": schema.SetAttribute{
+							MarkdownDescription: "IDs of custom moderation actions, provided by an extension, which are run when the rule triggers.",
+							ElementType:         types.StringType,
+							Optional:            true,
+							Computed:            true,
+							Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteReportingConfigurationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteReportingConfigurationModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.ModerationRules.IsNull() || data.ModerationRules.IsUnknown() {
+		return
+	}
+
+	rules := []AccelByteReportingModerationRuleModel{}
+	resp.Diagnostics.Append(data.ModerationRules.ElementsAs(ctx, &rules, false)...)
+
+	ruleKeys := map[string]bool{}
+	for index, rule := range rules {
+		if rule.Category.IsUnknown() || rule.ExtensionCategory.IsUnknown() || rule.Reason.IsUnknown() {
+			continue
+		}
+		ruleKey := reportingModerationRuleKey(rule.Category.ValueString(), rule.ExtensionCategory.ValueString(), rule.Reason.ValueString())
+		if ruleKeys[ruleKey] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("moderation_rules").AtListIndex(index),
+				"Duplicate moderation rule",
+				fmt.Sprintf("Attribute `moderation_rules` contains more than one rule for category '%s', extension category '%s' and reason '%s'", rule.Category.ValueString(), rule.ExtensionCategory.ValueString(), rule.Reason.ValueString()),
+			)
+		}
+		ruleKeys[ruleKey] = true
+	}
+}
+
+func (r *AccelByteReportingConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.ReportingAdminConfigurationsService
+	r.moderationRuleClient = clients.ReportingAdminModerationRuleService
+}
+
+func (r *AccelByteReportingConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteReportingConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating reporting configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	// The reporting configuration always exists; creating the resource updates it
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingConfigurationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteReportingConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The reporting configuration always exists, so there is no not-found case here
+	input := &admin_configurations.GetParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+	config, err := r.client.GetShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading reporting configuration via AccelByte API", fmt.Sprintf("Unable to read reporting configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	moderationRules, err := r.listModerationRules(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading moderation rules via AccelByte API", fmt.Sprintf("Unable to read moderation rules in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return
+	}
+
+	tflog.Trace(ctx, "Read reporting configuration from AccelByte API", map[string]interface{}{
+		"namespace":       data.Namespace,
+		"config":          config,
+		"moderationRules": moderationRules,
+	})
+
+	resp.Diagnostics.Append(updateFromApiReportingConfiguration(ctx, &data, config, moderationRules)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteReportingConfigurationModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating reporting configuration via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	resp.Diagnostics.Append(r.write(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingConfigurationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteReportingConfigurationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting moderation rules via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+	})
+
+	// The reporting limits cannot be deleted; they retain their current values
+	// The moderation rules are all owned by this resource, so remove every one of them
+	currentRules, err := r.listModerationRules(data.Namespace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading moderation rules via AccelByte API", fmt.Sprintf("Unable to read moderation rules in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyModerationRules(data.Namespace.ValueString(), []*reportingclientmodels.RestapiModerationRuleRequest{}, currentRules)...)
+}
+
+func (r *AccelByteReportingConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace")
+}
+
+// Update the reporting limits and the moderation rules so that they match the TF plan `data`, then refresh `data` from the AccelByte API
+func (r *AccelByteReportingConfigurationResource) write(ctx context.Context, data *AccelByteReportingConfigurationModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	reportingLimit, reportingLimitDiags := toApiReportingLimit(ctx, *data)
+	diags.Append(reportingLimitDiags...)
+	moderationRules, moderationRulesDiags := toApiReportingModerationRules(ctx, *data)
+	diags.Append(moderationRulesDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	input := &admin_configurations.UpsertParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      reportingLimit,
+	}
+	config, err := r.client.UpsertShort(input)
+	if err != nil {
+		diags.AddError("Error when updating reporting configuration via AccelByte API", fmt.Sprintf("Unable to update reporting configuration in namespace '%s', got error: %s", input.Namespace, err))
+		return diags
+	}
+
+	currentRules, err := r.listModerationRules(data.Namespace.ValueString())
+	if err != nil {
+		diags.AddError("Error when reading moderation rules via AccelByte API", fmt.Sprintf("Unable to read moderation rules in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return diags
+	}
+
+	diags.Append(r.applyModerationRules(data.Namespace.ValueString(), moderationRules, currentRules)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Creating a moderation rule does not return the rule, so fetch all rules again
+	updatedRules, err := r.listModerationRules(data.Namespace.ValueString())
+	if err != nil {
+		diags.AddError("Error when reading moderation rules via AccelByte API", fmt.Sprintf("Unable to read moderation rules in namespace '%s', got error: %s", data.Namespace.ValueString(), err))
+		return diags
+	}
+
+	diags.Append(updateFromApiReportingConfiguration(ctx, data, config, updatedRules)...)
+
+	return diags
+}
+
+// Fetch all moderation rules in the namespace, one page at a time
+func (r *AccelByteReportingConfigurationResource) listModerationRules(namespace string) ([]*reportingclientmodels.RestapiModerationRuleResponse, error) {
+
+	moderationRules := []*reportingclientmodels.RestapiModerationRuleResponse{}
+	limit := int64(reportingModerationRulesPageSize)
+	for offset := int64(0); ; offset += limit {
+		offset := offset
+		rules, err := r.moderationRuleClient.GetModerationRulesShort(&admin_moderation_rule.GetModerationRulesParams{
+			Namespace: namespace,
+			Limit:     &limit,
+			Offset:    &offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		moderationRules = append(moderationRules, rules.Data...)
+
+		if len(rules.Data) < int(limit) {
+			return moderationRules, nil
+		}
+	}
+}
+
+// Update, create and delete moderation rules so that the rules in the namespace match `desiredRules`
+// Rules are matched up by their category, extension category and reason
+func (r *AccelByteReportingConfigurationResource) applyModerationRules(namespace string, desiredRules []*reportingclientmodels.RestapiModerationRuleRequest, currentRules []*reportingclientmodels.RestapiModerationRuleResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	currentRuleIds := map[string]string{}
+	for _, currentRule := range currentRules {
+		currentRuleIds[reportingModerationRuleKey(*currentRule.Category, currentRule.ExtensionCategory, *currentRule.Reason)] = *currentRule.ID
+	}
+
+	desiredRuleKeys := map[string]bool{}
+	for _, desiredRule := range desiredRules {
+		desiredRuleKeys[reportingModerationRuleKey(*desiredRule.Category, desiredRule.ExtensionCategory, *desiredRule.Reason)] = true
+	}
+
+	// Delete rules which are no longer wanted first, so that they do not count against any limits while the remaining rules are created
+	for _, currentRule := range currentRules {
+		if desiredRuleKeys[reportingModerationRuleKey(*currentRule.Category, currentRule.ExtensionCategory, *currentRule.Reason)] {
+			continue
+		}
+		err := r.moderationRuleClient.DeleteModerationRuleShort(&admin_moderation_rule.DeleteModerationRuleParams{
+			Namespace: namespace,
+			RuleID:    *currentRule.ID,
+		})
+		if err != nil {
+			diags.AddError("Error when deleting moderation rule via AccelByte API", fmt.Sprintf("Unable to delete moderation rule '%s' in namespace '%s', got error: %s", *currentRule.ID, namespace, err))
+			return diags
+		}
+	}
+
+	for _, desiredRule := range desiredRules {
+		if ruleId, ok := currentRuleIds[reportingModerationRuleKey(*desiredRule.Category, desiredRule.ExtensionCategory, *desiredRule.Reason)]; ok {
+			_, err := r.moderationRuleClient.UpdateModerationRuleShort(&admin_moderation_rule.UpdateModerationRuleParams{
+				Namespace: namespace,
+				RuleID:    ruleId,
+				Body:      desiredRule,
+			})
+			if err != nil {
+				diags.AddError("Error when updating moderation rule via AccelByte API", fmt.Sprintf("Unable to update moderation rule '%s' in namespace '%s', got error: %s", ruleId, namespace, err))
+				return diags
+			}
+		} else {
+			err := r.moderationRuleClient.CreateModerationRuleShort(&admin_moderation_rule.CreateModerationRuleParams{
+				Namespace: namespace,
+				Body:      desiredRule,
+			})
+			if err != nil {
+				diags.AddError("Error when creating moderation rule via AccelByte API", fmt.Sprintf("Unable to create moderation rule for category '%s' and reason '%s' in namespace '%s', got error: %s", *desiredRule.Category, *desiredRule.Reason, namespace, err))
+				return diags
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteReportingReasonGroupModel is used by AccelByteReportingReasonGroupResource.
+type AccelByteReportingReasonGroupModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	GroupId types.String `tfsdk:"group_id"`
+	Id      types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Title types.String `tfsdk:"title"`
+}
+
+// Used by Create, Read and Update operations on report reason groups.
+// This copies data from the AccelByte API `reasonGroup` to the TF state `data`.
+// The reasons in the group are managed through the `group_ids` attribute of `accelbyte_reporting_reason`.
+func updateFromApiReportingReasonGroup(data *AccelByteReportingReasonGroupModel, reasonGroup *reportingclientmodels.RestapiReasonGroupResponse) {
+	data.GroupId = types.StringValue(*reasonGroup.ID)
+	data.Id = types.StringValue(computeReportingReasonGroupId(data.Namespace.ValueString(), *reasonGroup.ID))
+
+	data.Title = types.StringPointerValue(reasonGroup.Title)
+}
+
+func computeReportingReasonGroupId(namespace string, groupId string) string {
+	return fmt.Sprintf("%s/%s", namespace, groupId)
+}
@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclient/admin_reasons"
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/reporting"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteReportingReasonGroupResource{}
+var _ resource.ResourceWithImportState = &AccelByteReportingReasonGroupResource{}
+
+func NewAccelByteReportingReasonGroupResource() resource.Resource {
+	return &AccelByteReportingReasonGroupResource{}
+}
+
+// AccelByteReportingReasonGroupResource defines the resource implementation.
+type AccelByteReportingReasonGroupResource struct {
+	client *reporting.AdminReasonsService
+}
+
+func (r *AccelByteReportingReasonGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reporting_reason_group"
+}
+
+func (r *AccelByteReportingReasonGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a report reason group. Games use groups to show different sets of report reasons in different contexts, for example when reporting a player versus reporting a chat message.\n\nReasons are added to a group through the `group_ids` attribute of `accelbyte_reporting_reason`.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the report reason group. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "Report reason group ID, as assigned by the Reporting service when the group was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Report reason group identifier, on the format `{{namespace}}/{{group_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the report reason group. Must be unique within the namespace.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteReportingReasonGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.ReportingAdminReasonsService
+}
+
+func (r *AccelByteReportingReasonGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteReportingReasonGroupModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating report reason group via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"title":     data.Title,
+	})
+
+	input := &admin_reasons.CreateReasonGroupParams{
+		Namespace: data.Namespace.ValueString(),
+		Body: &reportingclientmodels.RestapiCreateReasonGroupRequest{
+			Title: data.Title.ValueStringPointer(),
+		},
+	}
+
+	reasonGroup, err := r.client.CreateReasonGroupShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating report reason group via AccelByte API", fmt.Sprintf("Unable to create report reason group '%s' in namespace '%s', got error: %s", data.Title.ValueString(), input.Namespace, err))
+		return
+	}
+
+	updateFromApiReportingReasonGroup(&data, reasonGroup)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingReasonGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteReportingReasonGroupModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &admin_reasons.GetReasonGroupParams{
+		Namespace: data.Namespace.ValueString(),
+		GroupID:   data.GroupId.ValueString(),
+	}
+	reasonGroup, err := r.client.GetReasonGroupShort(input)
+	if err != nil {
+		notFoundError := &admin_reasons.GetReasonGroupNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading report reason group via AccelByte API", fmt.Sprintf("Unable to read report reason group '%s' in namespace '%s', got error: %s", input.GroupID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read report reason group from AccelByte API", map[string]interface{}{
+		"namespace":   data.Namespace,
+		"groupId":     data.GroupId,
+		"reasonGroup": reasonGroup,
+	})
+
+	updateFromApiReportingReasonGroup(&data, reasonGroup)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingReasonGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteReportingReasonGroupModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The group's reasons are managed by accelbyte_reporting_reason; send the current reasons back so that the update leaves them unchanged
+	getInput := &admin_reasons.GetReasonGroupParams{
+		Namespace: data.Namespace.ValueString(),
+		GroupID:   data.GroupId.ValueString(),
+	}
+	currentReasonGroup, err := r.client.GetReasonGroupShort(getInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading report reason group via AccelByte API", fmt.Sprintf("Unable to read report reason group '%s' in namespace '%s', got error: %s", getInput.GroupID, getInput.Namespace, err))
+		return
+	}
+
+	reasonIds := []string{}
+	for _, reason := range currentReasonGroup.Reasons {
+		reasonIds = append(reasonIds, *reason.ID)
+	}
+
+	tflog.Trace(ctx, "Updating report reason group via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"groupId":   data.GroupId,
+	})
+
+	input := &admin_reasons.UpdateReasonGroupParams{
+		Namespace: data.Namespace.ValueString(),
+		GroupID:   data.GroupId.ValueString(),
+		Body: &reportingclientmodels.RestapiUpdateReasonGroupRequest{
+			Title:     data.Title.ValueStringPointer(),
+			ReasonIds: reasonIds,
+		},
+	}
+
+	reasonGroup, err := r.client.UpdateReasonGroupShort(input)
+	if err != nil {
+		// Failed to update the resource in the AccelByte backend
+		// The backend refused our update operation; we should abort
+		resp.Diagnostics.AddError("Error when updating report reason group via AccelByte API", fmt.Sprintf("Unable to update report reason group '%s' in namespace '%s', got error: %s", input.GroupID, input.Namespace, err))
+		return
+	}
+
+	updateFromApiReportingReasonGroup(&data, reasonGroup)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingReasonGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteReportingReasonGroupModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting report reason group via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"groupId":   data.GroupId,
+	})
+
+	input := &admin_reasons.DeleteReasonGroupParams{
+		Namespace: data.Namespace.ValueString(),
+		GroupID:   data.GroupId.ValueString(),
+	}
+	err := r.client.DeleteReasonGroupShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting report reason group via AccelByte API", fmt.Sprintf("Unable to delete report reason group '%s' in namespace '%s', got error: %s", input.GroupID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteReportingReasonGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "group_id")
+}
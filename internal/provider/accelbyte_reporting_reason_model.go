@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteReportingReasonModel is used by AccelByteReportingReasonResource.
+type AccelByteReportingReasonModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Computed during Create() operation
+	ReasonId types.String `tfsdk:"reason_id"`
+	Id       types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Title types.String `tfsdk:"title"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Description types.String `tfsdk:"description"`
+	GroupIds    types.Set    `tfsdk:"group_ids"` // []string
+}
+
+// Used by Create, Read and Update operations on report reasons.
+// This copies data from the AccelByte API `reason` to the TF state `data`.
+func updateFromApiReportingReason(ctx context.Context, data *AccelByteReportingReasonModel, reason *reportingclientmodels.RestapiAdminReasonResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*reason.Namespace)
+	data.ReasonId = types.StringValue(*reason.ID)
+	data.Id = types.StringValue(computeReportingReasonId(*reason.Namespace, *reason.ID))
+
+	data.Title = types.StringPointerValue(reason.Title)
+	data.Description = types.StringPointerValue(reason.Description)
+
+	groupIds := []string{}
+	for _, group := range reason.Groups {
+		groupIds = append(groupIds, *group.ID)
+	}
+
+	groupIdsSet, groupIdsDiags := types.SetValueFrom(ctx, types.StringType, groupIds)
+	data.GroupIds = groupIdsSet
+	diags.Append(groupIdsDiags...)
+
+	return diags
+}
+
+// Used by Create and Update operations on report reasons.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiReportingReason(ctx context.Context, data AccelByteReportingReasonModel) (*reportingclientmodels.RestapiCreateReasonRequest, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	groupIds := []string{}
+	diags.Append(data.GroupIds.ElementsAs(ctx, &groupIds, false)...)
+
+	return &reportingclientmodels.RestapiCreateReasonRequest{
+		Title:       data.Title.ValueStringPointer(),
+		Description: data.Description.ValueStringPointer(),
+		GroupIds:    groupIds,
+	}, diags
+}
+
+func computeReportingReasonId(namespace string, reasonId string) string {
+	return fmt.Sprintf("%s/%s", namespace, reasonId)
+}
@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/reporting-sdk/pkg/reportingclient/admin_reasons"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/reporting"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteReportingReasonResource{}
+var _ resource.ResourceWithImportState = &AccelByteReportingReasonResource{}
+
+func NewAccelByteReportingReasonResource() resource.Resource {
+	return &AccelByteReportingReasonResource{}
+}
+
+// AccelByteReportingReasonResource defines the resource implementation.
+type AccelByteReportingReasonResource struct {
+	client *reporting.AdminReasonsService
+}
+
+func (r *AccelByteReportingReasonResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reporting_reason"
+}
+
+func (r *AccelByteReportingReasonResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a report reason, which players pick when reporting a player or a piece of content. Reasons are also referenced by the moderation rules in `accelbyte_reporting_configuration`.\n\nThe Reporting service stores a single title and description per reason; localize them in the game client, using the title as a key.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the report reason. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"reason_id": schema.StringAttribute{
+				MarkdownDescription: "Report reason ID, as assigned by the Reporting service when the reason was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Report reason identifier, on the format `{{namespace}}/{{reason_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the report reason. Must be unique within the namespace. Moderation rules refer to reasons by title.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the report reason.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"group_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the report reason groups which contain this reason. These are typically taken from the `group_id` attribute of `accelbyte_reporting_reason_group` resources.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(basetypes.NewSetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccelByteReportingReasonResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.ReportingAdminReasonsService
+}
+
+func (r *AccelByteReportingReasonResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteReportingReasonModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReason, apiReasonDiags := toApiReportingReason(ctx, data)
+	resp.Diagnostics.Append(apiReasonDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating report reason via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"title":     data.Title,
+	})
+
+	input := &admin_reasons.CreateReasonParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiReason,
+	}
+
+	reason, err := r.client.CreateReasonShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating report reason via AccelByte API", fmt.Sprintf("Unable to create report reason '%s' in namespace '%s', got error: %s", data.Title.ValueString(), input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiReportingReason(ctx, &data, reason)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingReasonResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteReportingReasonModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &admin_reasons.AdminGetReasonParams{
+		Namespace: data.Namespace.ValueString(),
+		ReasonID:  data.ReasonId.ValueString(),
+	}
+	reason, err := r.client.AdminGetReasonShort(input)
+	if err != nil {
+		notFoundError := &admin_reasons.AdminGetReasonNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading report reason via AccelByte API", fmt.Sprintf("Unable to read report reason '%s' in namespace '%s', got error: %s", input.ReasonID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read report reason from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"reasonId":  data.ReasonId,
+		"reason":    reason,
+	})
+
+	resp.Diagnostics.Append(updateFromApiReportingReason(ctx, &data, reason)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingReasonResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteReportingReasonModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiReason, apiReasonDiags := toApiReportingReason(ctx, data)
+	resp.Diagnostics.Append(apiReasonDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating report reason via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"reasonId":  data.ReasonId,
+	})
+
+	input := &admin_reasons.UpdateReasonParams{
+		Namespace: data.Namespace.ValueString(),
+		ReasonID:  data.ReasonId.ValueString(),
+		Body:      apiReason,
+	}
+
+	reason, err := r.client.UpdateReasonShort(input)
+	if err != nil {
+		// Failed to update the resource in the AccelByte backend
+		// The backend refused our update operation; we should abort
+		resp.Diagnostics.AddError("Error when updating report reason via AccelByte API", fmt.Sprintf("Unable to update report reason '%s' in namespace '%s', got error: %s", input.ReasonID, input.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiReportingReason(ctx, &data, reason)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteReportingReasonResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteReportingReasonModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting report reason via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"reasonId":  data.ReasonId,
+	})
+
+	input := &admin_reasons.DeleteReasonParams{
+		Namespace: data.Namespace.ValueString(),
+		ReasonID:  data.ReasonId.ValueString(),
+	}
+	err := r.client.DeleteReasonShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when deleting report reason via AccelByte API", fmt.Sprintf("Unable to delete report reason '%s' in namespace '%s', got error: %s", input.ReasonID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteReportingReasonResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "reason_id")
+}
@@ -66,6 +66,14 @@ type AccelByteSessionTemplateModel struct {
 	CustomAttributes types.String `tfsdk:"custom_attributes"`
 }
 
+// AccelByteSessionTemplateResourceModel extends AccelByteSessionTemplateModel with settings that only exist in AccelByteSessionTemplateResource.
+type AccelByteSessionTemplateResourceModel struct {
+	AccelByteSessionTemplateModel
+
+	// Only used by Terraform; not stored in the AccelByte backend
+	ValidateAmsRegions types.Bool `tfsdk:"validate_ams_regions"`
+}
+
 var AccelByteSessionTemplateCustomSessionFunctionModelAttributeTypes = map[string]attr.Type{
 	"on_session_created": types.BoolType,
 	"on_session_updated": types.BoolType,
@@ -7,7 +7,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/AccelByte/accelbyte-go-sdk/ams-sdk/pkg/amsclient/a_m_s_info"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/ams"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/session"
 	"github.com/AccelByte/accelbyte-go-sdk/session-sdk/pkg/sessionclient/configuration_template"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
@@ -32,6 +36,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &AccelByteSessionTemplateResource{}
 var _ resource.ResourceWithImportState = &AccelByteSessionTemplateResource{}
+var _ resource.ResourceWithModifyPlan = &AccelByteSessionTemplateResource{}
 
 func NewAccelByteSessionTemplateResource() resource.Resource {
 	return &AccelByteSessionTemplateResource{}
@@ -39,7 +44,8 @@ func NewAccelByteSessionTemplateResource() resource.Resource {
 
 // AccelByteSessionTemplateResource defines the resource implementation.
 type AccelByteSessionTemplateResource struct {
-	client *session.ConfigurationTemplateService
+	client        *session.ConfigurationTemplateService
+	amsInfoClient *ams.AMSInfoService
 }
 
 func (r *AccelByteSessionTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -333,6 +339,15 @@ func (r *AccelByteSessionTemplateResource) Schema(ctx context.Context, req resou
 				MarkdownDescription: "",
 				Optional:            true,
 			},
+
+			// Only used by Terraform; not stored in the AccelByte backend
+
+			"validate_ams_regions": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, all regions in `ams_server.requested_regions` will be checked during planning against the list of regions that AMS offers. Regions that AMS does not offer will be reported as errors.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -355,10 +370,11 @@ func (r *AccelByteSessionTemplateResource) Configure(ctx context.Context, req re
 	}
 
 	r.client = clients.SessionConfigurationTemplateService
+	r.amsInfoClient = clients.AmsInfoService
 }
 
 func (r *AccelByteSessionTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data AccelByteSessionTemplateModel
+	var data AccelByteSessionTemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -369,7 +385,7 @@ func (r *AccelByteSessionTemplateResource) Create(ctx context.Context, req resou
 
 	data.Id = types.StringValue(computeSessionTemplateId(data.Namespace.ValueString(), data.Name.ValueString()))
 
-	apiSessionTemplate, diags, err := toApiSessionTemplate(ctx, data)
+	apiSessionTemplate, diags, err := toApiSessionTemplate(ctx, data.AccelByteSessionTemplateModel)
 	resp.Diagnostics.Append(diags...)
 	if err != nil {
 		resp.Diagnostics.AddError("Error when converting our internal state to an AccelByte API session template", fmt.Sprintf("Error: %#v", err))
@@ -393,7 +409,7 @@ func (r *AccelByteSessionTemplateResource) Create(ctx context.Context, req resou
 		return
 	}
 
-	updateDiags, err := updateFromApiSessionTemplate(ctx, &data, configurationTemplate)
+	updateDiags, err := updateFromApiSessionTemplate(ctx, &data.AccelByteSessionTemplateModel, configurationTemplate)
 	resp.Diagnostics.Append(updateDiags...)
 	if err != nil {
 		resp.Diagnostics.AddError("Error when updating session template model according to AccelByte API response", fmt.Sprintf("Unable to process API response for session template '%s' in namespace '%s' into model, got error: %s", *input.Body.Name, input.Namespace, err))
@@ -405,7 +421,7 @@ func (r *AccelByteSessionTemplateResource) Create(ctx context.Context, req resou
 }
 
 func (r *AccelByteSessionTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data AccelByteSessionTemplateModel
+	var data AccelByteSessionTemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -435,13 +451,18 @@ func (r *AccelByteSessionTemplateResource) Read(ctx context.Context, req resourc
 		}
 	}
 
+	// The region validation setting does not exist in the AccelByte backend; ensure that it has a value after import
+	if data.ValidateAmsRegions.IsNull() {
+		data.ValidateAmsRegions = types.BoolValue(false)
+	}
+
 	tflog.Trace(ctx, "Read session template from AccelByte API", map[string]interface{}{
 		"namespace":      data.Namespace,
 		"name":           data.Name.ValueString(),
 		"configTemplate": configTemplate,
 	})
 
-	updateDiags, err := updateFromApiSessionTemplate(ctx, &data, configTemplate)
+	updateDiags, err := updateFromApiSessionTemplate(ctx, &data.AccelByteSessionTemplateModel, configTemplate)
 	resp.Diagnostics.Append(updateDiags...)
 	if err != nil {
 		resp.Diagnostics.AddError("Error when updating session template model according to AccelByte API response", fmt.Sprintf("Unable to process API response for session template '%s' in namespace '%s' into model, got error: %s", input.Name, input.Namespace, err))
@@ -453,7 +474,7 @@ func (r *AccelByteSessionTemplateResource) Read(ctx context.Context, req resourc
 }
 
 func (r *AccelByteSessionTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data AccelByteSessionTemplateModel
+	var data AccelByteSessionTemplateResourceModel
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -462,7 +483,7 @@ func (r *AccelByteSessionTemplateResource) Update(ctx context.Context, req resou
 		return
 	}
 
-	apiSessionTemplateConfig, diagnostics, err := toApiSessionTemplateConfig(ctx, data)
+	apiSessionTemplateConfig, diagnostics, err := toApiSessionTemplateConfig(ctx, data.AccelByteSessionTemplateModel)
 	resp.Diagnostics.Append(diagnostics...)
 	if err != nil {
 		resp.Diagnostics.AddError("Error when converting our internal state to an AccelByte API session template config", fmt.Sprintf("Error: %#v", err))
@@ -497,7 +518,7 @@ func (r *AccelByteSessionTemplateResource) Update(ctx context.Context, req resou
 		}
 	}
 
-	updateDiags, err := updateFromApiSessionTemplate(ctx, &data, apiSessionTemplate)
+	updateDiags, err := updateFromApiSessionTemplate(ctx, &data.AccelByteSessionTemplateModel, apiSessionTemplate)
 	resp.Diagnostics.Append(updateDiags...)
 	if err != nil {
 		resp.Diagnostics.AddError("Error when updating session template model according to AccelByte API response", fmt.Sprintf("Unable to process API response for session template '%s' in namespace '%s' into model, got error: %s", input.Name, input.Namespace, err))
@@ -509,7 +530,7 @@ func (r *AccelByteSessionTemplateResource) Update(ctx context.Context, req resou
 }
 
 func (r *AccelByteSessionTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data AccelByteSessionTemplateModel
+	var data AccelByteSessionTemplateResourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -534,6 +555,69 @@ func (r *AccelByteSessionTemplateResource) Delete(ctx context.Context, req resou
 	}
 }
 
+func (r *AccelByteSessionTemplateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// There is nothing to validate when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data AccelByteSessionTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ValidateAmsRegions.ValueBool() || data.AmsServer.IsNull() || data.AmsServer.IsUnknown() || data.Namespace.IsUnknown() {
+		return
+	}
+
+	// The provider may not have been configured yet, for example when the provider configuration depends on values that are unknown during planning
+	if r.amsInfoClient == nil {
+		return
+	}
+
+	var amsServer AccelByteSessionTemplateAmsServerModel
+	resp.Diagnostics.Append(data.AmsServer.As(ctx, &amsServer, basetypes.ObjectAsOptions{UnhandledUnknownAsEmpty: true})...)
+
+	if resp.Diagnostics.HasError() || amsServer.RequestedRegions.IsNull() || amsServer.RequestedRegions.IsUnknown() {
+		return
+	}
+
+	requestedRegions := make([]types.String, len(amsServer.RequestedRegions.Elements()))
+	resp.Diagnostics.Append(amsServer.RequestedRegions.ElementsAs(ctx, &requestedRegions, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &a_m_s_info.InfoRegionsParams{
+		Namespace: data.Namespace.ValueString(),
+	}
+
+	amsRegions, err := r.amsInfoClient.InfoRegionsShort(input)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when reading AMS regions via AccelByte API", fmt.Sprintf("Unable to read AMS regions in namespace '%s', got error: %s", input.Namespace, err))
+		return
+	}
+
+	for index, requestedRegion := range requestedRegions {
+		if requestedRegion.IsUnknown() || requestedRegion.IsNull() {
+			continue
+		}
+
+		if !slices.Contains(amsRegions.Regions, requestedRegion.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ams_server").AtName("requested_regions").AtListIndex(index),
+				"Unknown AMS region",
+				fmt.Sprintf("Region '%s' is not offered by AMS in namespace '%s'. Available regions: %s", requestedRegion.ValueString(), input.Namespace, strings.Join(amsRegions.Regions, ", ")),
+			)
+		}
+	}
+}
+
 func (r *AccelByteSessionTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclientmodels"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteStatisticCycleModel is used by AccelByteStatisticCycleResource.
+type AccelByteStatisticCycleModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+
+	// Can be set by user during resource creation; will otherwise be generated by the API
+	CycleId types.String `tfsdk:"cycle_id"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Name      types.String `tfsdk:"name"`
+	CycleType types.String `tfsdk:"cycle_type"`
+	ResetTime types.String `tfsdk:"reset_time"`
+	Start     types.String `tfsdk:"start"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or from the API
+	Description  types.String `tfsdk:"description"`
+	End          types.String `tfsdk:"end"`
+	ResetDay     types.Int32  `tfsdk:"reset_day"`
+	ResetDate    types.Int32  `tfsdk:"reset_date"`
+	ResetMonth   types.Int32  `tfsdk:"reset_month"`
+	SeasonPeriod types.Int32  `tfsdk:"season_period"`
+	StatCodes    types.Set    `tfsdk:"stat_codes"` // []string
+	Status       types.String `tfsdk:"status"`
+
+	// Computed during Read() operation
+	CurrentVersion types.Int32  `tfsdk:"current_version"`
+	NextReset      types.String `tfsdk:"next_reset"`
+}
+
+// Used by Create, Read and Update operations on statistic cycles.
+// This copies data from the AccelByte API `cycle` and `statCodes` to the TF state `data`.
+func updateFromApiStatisticCycle(ctx context.Context, data *AccelByteStatisticCycleModel, cycle *socialclientmodels.StatCycleInfo, statCodes []string) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*cycle.Namespace)
+	data.CycleId = types.StringValue(*cycle.ID)
+	data.Id = types.StringValue(computeStatisticCycleId(*cycle.Namespace, *cycle.ID))
+
+	data.Name = types.StringValue(*cycle.Name)
+	data.CycleType = types.StringValue(*cycle.CycleType)
+	data.ResetTime = types.StringValue(*cycle.ResetTime)
+	data.Start = fromApiTimestamp(data.Start, &cycle.Start)
+
+	data.Description = types.StringValue(cycle.Description)
+	data.End = fromApiTimestamp(data.End, cycle.End)
+
+	// Reset schedule fields that do not apply to the cycle type are omitted by the API; these are reflected as nulls in the state
+	data.ResetDay = fromApiStatisticCycleResetField(cycle.ResetDay)
+	data.ResetDate = fromApiStatisticCycleResetField(cycle.ResetDate)
+	data.ResetMonth = fromApiStatisticCycleResetField(cycle.ResetMonth)
+	data.SeasonPeriod = fromApiStatisticCycleResetField(cycle.SeasonPeriod)
+
+	statCodesSet, statCodesDiags := setValueFromEvenIfNil(ctx, types.StringType, statCodes)
+	data.StatCodes = statCodesSet
+	diags.Append(statCodesDiags...)
+
+	// A cycle whose start time has not yet been reached is reported as INIT; it will become ACTIVE without further action
+	// Do not report a difference if the user has asked for the cycle to be ACTIVE
+	if !(data.Status.ValueString() == socialclientmodels.StatCycleInfoStatusACTIVE && *cycle.Status == socialclientmodels.StatCycleInfoStatusINIT) {
+		data.Status = types.StringPointerValue(cycle.Status)
+	}
+
+	data.CurrentVersion = types.Int32PointerValue(cycle.CurrentVersion)
+	data.NextReset = types.StringValue("")
+	if cycle.NextReset != nil {
+		data.NextReset = types.StringValue(time.Time(*cycle.NextReset).UTC().Format(time.RFC3339))
+	}
+
+	return diags
+}
+
+func fromApiStatisticCycleResetField(apiValue int32) types.Int32 {
+	if apiValue == 0 {
+		return types.Int32Null()
+	}
+	return types.Int32Value(apiValue)
+}
+
+// Used by the Create operation on statistic cycles.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Stat codes are not included; these are managed via separate API calls.
+func toApiStatisticCycleCreate(data AccelByteStatisticCycleModel) (*socialclientmodels.StatCycleCreate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	start, end, timeDiags := toApiStatisticCycleTimes(data)
+	diags.Append(timeDiags...)
+
+	cycle := &socialclientmodels.StatCycleCreate{
+		ID:           data.CycleId.ValueString(),
+		Name:         data.Name.ValueStringPointer(),
+		CycleType:    data.CycleType.ValueStringPointer(),
+		ResetTime:    data.ResetTime.ValueStringPointer(),
+		Start:        start,
+		End:          end,
+		Description:  data.Description.ValueString(),
+		ResetDay:     data.ResetDay.ValueInt32(),
+		ResetDate:    data.ResetDate.ValueInt32(),
+		ResetMonth:   data.ResetMonth.ValueInt32(),
+		SeasonPeriod: data.SeasonPeriod.ValueInt32(),
+	}
+
+	return cycle, diags
+}
+
+// Used by the Update operation on statistic cycles.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Stat codes are not included; these are managed via separate API calls.
+func toApiStatisticCycleUpdate(data AccelByteStatisticCycleModel) (*socialclientmodels.StatCycleUpdate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	start, end, timeDiags := toApiStatisticCycleTimes(data)
+	diags.Append(timeDiags...)
+
+	cycle := &socialclientmodels.StatCycleUpdate{
+		Name:         data.Name.ValueStringPointer(),
+		CycleType:    data.CycleType.ValueStringPointer(),
+		ResetTime:    data.ResetTime.ValueStringPointer(),
+		Start:        start,
+		End:          end,
+		Description:  data.Description.ValueString(),
+		ResetDay:     data.ResetDay.ValueInt32(),
+		ResetDate:    data.ResetDate.ValueInt32(),
+		ResetMonth:   data.ResetMonth.ValueInt32(),
+		SeasonPeriod: data.SeasonPeriod.ValueInt32(),
+	}
+
+	return cycle, diags
+}
+
+func toApiStatisticCycleTimes(data AccelByteStatisticCycleModel) (strfmt.DateTime, *strfmt.DateTime, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	start, err := time.Parse(time.RFC3339, data.Start.ValueString())
+	if err != nil {
+		diags.AddError("Invalid start time", fmt.Sprintf("Unable to parse start time '%s' as an RFC3339 timestamp, got error: %s", data.Start.ValueString(), err))
+	}
+
+	var end *strfmt.DateTime = nil
+	if !data.End.IsNull() {
+		parsedEnd, err := time.Parse(time.RFC3339, data.End.ValueString())
+		if err != nil {
+			diags.AddError("Invalid end time", fmt.Sprintf("Unable to parse end time '%s' as an RFC3339 timestamp, got error: %s", data.End.ValueString(), err))
+		}
+		endDateTime := strfmt.DateTime(parsedEnd)
+		end = &endDateTime
+	}
+
+	return strfmt.DateTime(start), end, diags
+}
+
+func computeStatisticCycleId(namespace string, cycleId string) string {
+	return fmt.Sprintf("%s/%s", namespace, cycleId)
+}
@@ -0,0 +1,689 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/social"
+	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclient/stat_configuration"
+	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclient/stat_cycle_configuration"
+	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	STATISTIC_CYCLE_STATS_PAGE_SIZE = 100
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteStatisticCycleResource{}
+var _ resource.ResourceWithImportState = &AccelByteStatisticCycleResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteStatisticCycleResource{}
+var _ resource.ResourceWithModifyPlan = &AccelByteStatisticCycleResource{}
+
+func NewAccelByteStatisticCycleResource() resource.Resource {
+	return &AccelByteStatisticCycleResource{}
+}
+
+// AccelByteStatisticCycleResource defines the resource implementation.
+type AccelByteStatisticCycleResource struct {
+	client     *social.StatCycleConfigurationService
+	statClient *social.StatConfigurationService
+}
+
+func (r *AccelByteStatisticCycleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statistic_cycle"
+}
+
+func (r *AccelByteStatisticCycleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [statistic cycle](https://docs.accelbyte.io/gaming-services/services/storage/statistics/statistics-cycle/). A statistic cycle periodically resets the values of its statistics, for example for seasonal leaderboards or MMR resets.\n\n" +
+			"A cycle starts automatically once its `start` time is reached. The AccelByte API does not allow a stopped cycle to be started again; setting `status` to `ACTIVE` on a stopped cycle is an error. Use `terraform apply -replace` to re-create a stopped cycle instead.\n\nIf the cycle is created but its statistics cannot be added, or it cannot be stopped, the cycle is marked as tainted and will be replaced during the next apply.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the statistic cycle. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cycle_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the statistic cycle. Uppercase characters, lowercase characters, or digits. Max 32 characters in length. Will be generated if not set. Changing this forces the cycle to be re-created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-zA-Z0-9]+$`), "must consist of uppercase characters, lowercase characters, or digits"),
+					stringvalidator.LengthAtMost(32),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Statistic cycle identifier, on the format `{{namespace}}/{{cycle_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the statistic cycle. Max 128 characters in length.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"cycle_type": schema.StringAttribute{
+				MarkdownDescription: "How often the cycle resets. One of `DAILY`, `WEEKLY`, `MONTHLY`, `ANNUALLY` or `SEASONAL`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						socialclientmodels.StatCycleCreateCycleTypeDAILY,
+						socialclientmodels.StatCycleCreateCycleTypeWEEKLY,
+						socialclientmodels.StatCycleCreateCycleTypeMONTHLY,
+						socialclientmodels.StatCycleCreateCycleTypeANNUALLY,
+						socialclientmodels.StatCycleCreateCycleTypeSEASONAL,
+					),
+				},
+			},
+			"reset_time": schema.StringAttribute{
+				MarkdownDescription: "Time of day at which the cycle resets, in 24-hour `HH:MM` format (UTC).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of day in HH:MM format"),
+				},
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Time at which the cycle starts, as an RFC3339 timestamp; for example `2025-01-01T00:00:00Z`.",
+				Required:            true,
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the statistic cycle.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "Time at which the cycle ends, as an RFC3339 timestamp. If not set, the cycle continues indefinitely.",
+				Optional:            true,
+			},
+			"reset_day": schema.Int32Attribute{
+				MarkdownDescription: "Day of the week on which the cycle resets, from 1 (Monday) to 7 (Sunday). Required when `cycle_type` is `WEEKLY`.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 7),
+				},
+			},
+			"reset_date": schema.Int32Attribute{
+				MarkdownDescription: "Day of the month on which the cycle resets, from 1 to 31. Required when `cycle_type` is `MONTHLY` or `ANNUALLY`.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 31),
+				},
+			},
+			"reset_month": schema.Int32Attribute{
+				MarkdownDescription: "Month in which the cycle resets, from 1 to 12. Required when `cycle_type` is `ANNUALLY`.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 12),
+				},
+			},
+			"season_period": schema.Int32Attribute{
+				MarkdownDescription: "Length of each season, in days. Required when `cycle_type` is `SEASONAL`.",
+				Optional:            true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"stat_codes": schema.SetAttribute{
+				MarkdownDescription: "Codes of the statistics that are reset by this cycle. If not set, cycle membership is not managed by this resource; use this when membership is managed via `accelbyte_statistic.cycle_ids` instead.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the statistic cycle. Set to `STOPPED` to stop the cycle. A stopped cycle cannot be started again, so `ACTIVE` is only accepted while the cycle has not been stopped. A cycle whose start time has not yet been reached is reported as `INIT`, unless `status` is set to `ACTIVE`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(socialclientmodels.StatCycleInfoStatusACTIVE, socialclientmodels.StatCycleInfoStatusSTOPPED),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"current_version": schema.Int32Attribute{
+				MarkdownDescription: "Number of times the cycle has been reset.",
+				Computed:            true,
+			},
+			"next_reset": schema.StringAttribute{
+				MarkdownDescription: "Time of the next reset, as an RFC3339 timestamp. Empty if the cycle is not running.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccelByteStatisticCycleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteStatisticCycleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for attributeName, value := range map[string]types.String{"start": data.Start, "end": data.End} {
+		if value.IsUnknown() || value.IsNull() {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Invalid attribute value",
+				fmt.Sprintf("Attribute `%s` must be an RFC3339 timestamp, got error: %s", attributeName, err),
+			)
+		}
+	}
+
+	if data.CycleType.IsUnknown() || data.CycleType.IsNull() {
+		return
+	}
+
+	// Attributes that must be set for each cycle type
+	requiredAttributes := map[string][]string{
+		socialclientmodels.StatCycleCreateCycleTypeWEEKLY:   {"reset_day"},
+		socialclientmodels.StatCycleCreateCycleTypeMONTHLY:  {"reset_date"},
+		socialclientmodels.StatCycleCreateCycleTypeANNUALLY: {"reset_date", "reset_month"},
+		socialclientmodels.StatCycleCreateCycleTypeSEASONAL: {"season_period"},
+	}
+
+	attributeValues := map[string]types.Int32{
+		"reset_day":     data.ResetDay,
+		"reset_date":    data.ResetDate,
+		"reset_month":   data.ResetMonth,
+		"season_period": data.SeasonPeriod,
+	}
+
+	cycleType := data.CycleType.ValueString()
+	for attributeName, value := range attributeValues {
+		if value.IsUnknown() {
+			continue
+		}
+		isRequired := slices.Contains(requiredAttributes[cycleType], attributeName)
+		if isRequired && value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Missing required attribute",
+				fmt.Sprintf("Attribute `%s` must be set when `cycle_type` is '%s'", attributeName, cycleType),
+			)
+		}
+		if !isRequired && !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Invalid attribute combination",
+				fmt.Sprintf("Attribute `%s` cannot be set when `cycle_type` is '%s'", attributeName, cycleType),
+			)
+		}
+	}
+}
+
+func (r *AccelByteStatisticCycleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only an existing cycle can have been stopped
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state AccelByteStatisticCycleModel
+	var plan AccelByteStatisticCycleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() || plan.Status.IsUnknown() {
+		return
+	}
+
+	// The AccelByte API has no way to start a cycle; only stop it
+	if state.Status.ValueString() == socialclientmodels.StatCycleInfoStatusSTOPPED && plan.Status.ValueString() == socialclientmodels.StatCycleInfoStatusACTIVE {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Unable to start statistic cycle",
+			fmt.Sprintf("Statistic cycle '%s' in namespace '%s' has been stopped, and a stopped cycle cannot be started again. Set `status` to `STOPPED`, or re-create the cycle with `terraform apply -replace`.", state.CycleId.ValueString(), state.Namespace.ValueString()),
+		)
+	}
+}
+
+func (r *AccelByteStatisticCycleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SocialStatCycleConfigurationService
+	r.statClient = clients.SocialStatConfigurationService
+}
+
+func (r *AccelByteStatisticCycleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteStatisticCycleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCycle, apiCycleDiags := toApiStatisticCycleCreate(data)
+	resp.Diagnostics.Append(apiCycleDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating statistic cycle via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"cycleId":   data.CycleId,
+		"name":      data.Name,
+	})
+
+	createInput := &stat_cycle_configuration.CreateStatCycleParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiCycle,
+	}
+
+	cycle, err := r.client.CreateStatCycleShort(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating statistic cycle via AccelByte API", fmt.Sprintf("Unable to create statistic cycle '%s' in namespace '%s', got error: %s", *createInput.Body.Name, createInput.Namespace, err))
+		return
+	}
+
+	data.CycleId = types.StringValue(*cycle.ID)
+
+	// Save the cycle into Terraform state before adding statistics and stopping it, so that the cycle is tracked even if any of those steps fail
+	// If a later step fails, Terraform marks the cycle as tainted, and the next apply deletes and re-creates it
+	createdData := data
+	resp.Diagnostics.Append(updateFromApiStatisticCycle(ctx, &createdData, cycle, []string{})...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &createdData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyStatCodes(ctx, data, []string{})...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Status.ValueString() == socialclientmodels.StatCycleInfoStatusSTOPPED {
+		resp.Diagnostics.Append(r.stop(data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteStatisticCycleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteStatisticCycleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &stat_cycle_configuration.GetStatCycleParams{
+		Namespace: data.Namespace.ValueString(),
+		CycleID:   data.CycleId.ValueString(),
+	}
+
+	cycle, err := r.client.GetStatCycleShort(input)
+	if err != nil {
+		notFoundError := &stat_cycle_configuration.GetStatCycleNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading statistic cycle via AccelByte API", fmt.Sprintf("Unable to read statistic cycle '%s' in namespace '%s', got error: %s", input.CycleID, input.Namespace, err))
+			return
+		}
+	}
+
+	statCodes, err := r.listStatCodes(input.Namespace, input.CycleID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when listing statistics via AccelByte API", fmt.Sprintf("Unable to list statistics in statistic cycle '%s' in namespace '%s', got error: %s", input.CycleID, input.Namespace, err))
+		return
+	}
+
+	tflog.Trace(ctx, "Read statistic cycle from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"cycleId":   data.CycleId,
+		"cycle":     cycle,
+		"statCodes": statCodes,
+	})
+
+	resp.Diagnostics.Append(updateFromApiStatisticCycle(ctx, &data, cycle, statCodes)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteStatisticCycleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteStatisticCycleModel
+	var state AccelByteStatisticCycleModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiCycle, apiCycleDiags := toApiStatisticCycleUpdate(data)
+	resp.Diagnostics.Append(apiCycleDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating statistic cycle via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"cycleId":   data.CycleId,
+		"name":      data.Name,
+	})
+
+	input := &stat_cycle_configuration.UpdateStatCycleParams{
+		Namespace: data.Namespace.ValueString(),
+		CycleID:   data.CycleId.ValueString(),
+		Body:      apiCycle,
+	}
+
+	_, err := r.client.UpdateStatCycleShort(input)
+	if err != nil {
+		notFoundError := &stat_cycle_configuration.UpdateStatCycleNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Statistic cycle '%s' does not exist in namespace '%s'", input.CycleID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating statistic cycle via AccelByte API", fmt.Sprintf("Unable to update statistic cycle '%s' in namespace '%s', got error: %s", input.CycleID, input.Namespace, err))
+			return
+		}
+	}
+
+	currentStatCodes := []string{}
+	resp.Diagnostics.Append(state.StatCodes.ElementsAs(ctx, &currentStatCodes, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyStatCodes(ctx, data, currentStatCodes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Status.ValueString() == socialclientmodels.StatCycleInfoStatusSTOPPED && state.Status.ValueString() != socialclientmodels.StatCycleInfoStatusSTOPPED {
+		resp.Diagnostics.Append(r.stop(data)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteStatisticCycleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteStatisticCycleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting statistic cycle via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"cycleId":   data.CycleId,
+	})
+
+	input := &stat_cycle_configuration.DeleteStatCycleParams{
+		Namespace: data.Namespace.ValueString(),
+		CycleID:   data.CycleId.ValueString(),
+	}
+	err := r.client.DeleteStatCycleShort(input)
+	if err != nil {
+		notFoundError := &stat_cycle_configuration.DeleteStatCycleNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting statistic cycle via AccelByte API", fmt.Sprintf("Unable to delete statistic cycle '%s' in namespace '%s', got error: %s", input.CycleID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteStatisticCycleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "cycle_id")
+}
+
+// Fetch the statistic cycle after a write operation, along with the codes of the statistics that are part of it
+func (r *AccelByteStatisticCycleResource) read(ctx context.Context, data *AccelByteStatisticCycleModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	namespace := data.Namespace.ValueString()
+	cycleId := data.CycleId.ValueString()
+
+	cycle, err := r.client.GetStatCycleShort(&stat_cycle_configuration.GetStatCycleParams{
+		Namespace: namespace,
+		CycleID:   cycleId,
+	})
+	if err != nil {
+		diags.AddError("Error when reading statistic cycle via AccelByte API", fmt.Sprintf("Unable to read statistic cycle '%s' in namespace '%s', got error: %s", cycleId, namespace, err))
+		return diags
+	}
+
+	statCodes, err := r.listStatCodes(namespace, cycleId)
+	if err != nil {
+		diags.AddError("Error when listing statistics via AccelByte API", fmt.Sprintf("Unable to list statistics in statistic cycle '%s' in namespace '%s', got error: %s", cycleId, namespace, err))
+		return diags
+	}
+
+	diags.Append(updateFromApiStatisticCycle(ctx, data, cycle, statCodes)...)
+
+	return diags
+}
+
+// Fetch the codes of all statistics that are part of the statistic cycle, one page at a time
+func (r *AccelByteStatisticCycleResource) listStatCodes(namespace string, cycleId string) ([]string, error) {
+
+	statCodes := []string{}
+	limit := int32(STATISTIC_CYCLE_STATS_PAGE_SIZE)
+	for offset := int32(0); ; offset += limit {
+		offset := offset
+		stats, err := r.statClient.GetStatsShort(&stat_configuration.GetStatsParams{
+			Namespace: namespace,
+			CycleIds:  &cycleId,
+			Limit:     &limit,
+			Offset:    &offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, stat := range stats.Data {
+			statCodes = append(statCodes, *stat.StatCode)
+		}
+
+		if len(stats.Data) < int(limit) {
+			return statCodes, nil
+		}
+	}
+}
+
+// Add statistics to, and remove statistics from, the cycle so that they match the TF plan `data`
+// Statistics are removed from the cycle by updating the statistic itself
+func (r *AccelByteStatisticCycleResource) applyStatCodes(ctx context.Context, data AccelByteStatisticCycleModel, currentStatCodes []string) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	// Stat codes that are not set by the user are not managed by this resource
+	if data.StatCodes.IsUnknown() {
+		return diags
+	}
+
+	desiredStatCodes := []string{}
+	diags.Append(data.StatCodes.ElementsAs(ctx, &desiredStatCodes, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	namespace := data.Namespace.ValueString()
+	cycleId := data.CycleId.ValueString()
+
+	addedStatCodes := []string{}
+	for _, statCode := range desiredStatCodes {
+		if !slices.Contains(currentStatCodes, statCode) {
+			addedStatCodes = append(addedStatCodes, statCode)
+		}
+	}
+
+	if len(addedStatCodes) > 0 {
+		results, err := r.client.BulkAddStatsShort(&stat_cycle_configuration.BulkAddStatsParams{
+			Namespace: namespace,
+			CycleID:   cycleId,
+			Body: &socialclientmodels.BulkCycleStatsAdd{
+				StatCodes: addedStatCodes,
+			},
+		})
+		if err != nil {
+			diags.AddError("Error when adding statistics to statistic cycle via AccelByte API", fmt.Sprintf("Unable to add statistics %v to statistic cycle '%s' in namespace '%s', got error: %s", addedStatCodes, cycleId, namespace, err))
+			return diags
+		}
+		for _, result := range results {
+			if !result.Success {
+				diags.AddError("Error when adding statistics to statistic cycle via AccelByte API", fmt.Sprintf("Unable to add statistic '%s' to statistic cycle '%s' in namespace '%s', got details: %v", result.StatCode, cycleId, namespace, result.Details))
+			}
+		}
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	for _, statCode := range currentStatCodes {
+		if slices.Contains(desiredStatCodes, statCode) {
+			continue
+		}
+
+		stat, err := r.statClient.GetStatShort(&stat_configuration.GetStatParams{
+			Namespace: namespace,
+			StatCode:  statCode,
+		})
+		if err != nil {
+			notFoundError := &stat_configuration.GetStatNotFound{}
+			if errors.As(err, &notFoundError) {
+				// The statistic has been deleted, and is therefore no longer part of the cycle
+				continue
+			}
+			diags.AddError("Error when reading statistic via AccelByte API", fmt.Sprintf("Unable to read statistic '%s' in namespace '%s', got error: %s", statCode, namespace, err))
+			return diags
+		}
+
+		_, err = r.statClient.UpdateStatShort(&stat_configuration.UpdateStatParams{
+			Namespace: namespace,
+			StatCode:  statCode,
+			Body:      toApiStatisticUpdateWithoutCycle(stat, cycleId),
+		})
+		if err != nil {
+			diags.AddError("Error when removing statistic from statistic cycle via AccelByte API", fmt.Sprintf("Unable to remove statistic '%s' from statistic cycle '%s' in namespace '%s', got error: %s", statCode, cycleId, namespace, err))
+			return diags
+		}
+	}
+
+	return diags
+}
+
+// Stop the statistic cycle; the AccelByte API does not provide a way to start it again
+func (r *AccelByteStatisticCycleResource) stop(data AccelByteStatisticCycleModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	input := &stat_cycle_configuration.StopStatCycleParams{
+		Namespace: data.Namespace.ValueString(),
+		CycleID:   data.CycleId.ValueString(),
+	}
+
+	_, err := r.client.StopStatCycleShort(input)
+	if err != nil {
+		diags.AddError("Error when stopping statistic cycle via AccelByte API", fmt.Sprintf("Unable to stop statistic cycle '%s' in namespace '%s', got error: %s", input.CycleID, input.Namespace, err))
+	}
+
+	return diags
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteStatisticModel is used by AccelByteStatisticResource.
+type AccelByteStatisticModel struct {
+	// Populated by user
+	Namespace types.String `tfsdk:"namespace"`
+	StatCode  types.String `tfsdk:"stat_code"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Must be set by user during resource creation
+	Name         types.String  `tfsdk:"name"`
+	DefaultValue types.Float64 `tfsdk:"default_value"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema
+	Description   types.String  `tfsdk:"description"`
+	Minimum       types.Float64 `tfsdk:"minimum"`
+	Maximum       types.Float64 `tfsdk:"maximum"`
+	IncrementOnly types.Bool    `tfsdk:"increment_only"`
+	SetAsGlobal   types.Bool    `tfsdk:"set_as_global"`
+	SetBy         types.String  `tfsdk:"set_by"`
+	IsPublic      types.Bool    `tfsdk:"is_public"`
+	Tags          types.Set     `tfsdk:"tags"`      // []string
+	CycleIds      types.Set     `tfsdk:"cycle_ids"` // []string
+
+	// Computed during Read() operation
+	Status types.String `tfsdk:"status"`
+}
+
+// Used by Create, Read and Update operations on statistics.
+// This copies data from the AccelByte API `stat` to the TF state `data`.
+func updateFromApiStatistic(ctx context.Context, data *AccelByteStatisticModel, stat *socialclientmodels.StatInfo) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.Namespace = types.StringValue(*stat.Namespace)
+	data.StatCode = types.StringValue(*stat.StatCode)
+	data.Id = types.StringValue(computeStatisticId(*stat.Namespace, *stat.StatCode))
+
+	data.Name = types.StringValue(*stat.Name)
+	data.DefaultValue = types.Float64PointerValue(stat.DefaultValue)
+
+	data.Description = types.StringValue(stat.Description)
+
+	// The API omits minimum and maximum when they are zero; an unset bound in the TF state remains unset
+	if stat.Minimum != 0 || !data.Minimum.IsNull() {
+		data.Minimum = types.Float64Value(stat.Minimum)
+	}
+	if stat.Maximum != 0 || !data.Maximum.IsNull() {
+		data.Maximum = types.Float64Value(stat.Maximum)
+	}
+
+	data.IncrementOnly = types.BoolValue(stat.IncrementOnly != nil && *stat.IncrementOnly)
+	data.SetAsGlobal = types.BoolValue(stat.SetAsGlobal != nil && *stat.SetAsGlobal)
+	data.SetBy = types.StringPointerValue(stat.SetBy)
+	data.IsPublic = types.BoolValue(stat.IsPublic != nil && *stat.IsPublic)
+
+	tags, tagsDiags := setValueFromEvenIfNil(ctx, types.StringType, stat.Tags)
+	data.Tags = tags
+	diags.Append(tagsDiags...)
+
+	cycleIds, cycleIdsDiags := setValueFromEvenIfNil(ctx, types.StringType, stat.CycleIds)
+	data.CycleIds = cycleIds
+	diags.Append(cycleIdsDiags...)
+
+	data.Status = types.StringPointerValue(stat.Status)
+
+	return diags
+}
+
+// Used by the Create operation on statistics.
+// This reads from the TF state `data` and returns an AccelByte API object.
+func toApiStatisticCreate(ctx context.Context, data AccelByteStatisticModel) (*socialclientmodels.StatCreate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	stat := &socialclientmodels.StatCreate{
+		StatCode:      data.StatCode.ValueStringPointer(),
+		Name:          data.Name.ValueStringPointer(),
+		DefaultValue:  data.DefaultValue.ValueFloat64Pointer(),
+		Description:   data.Description.ValueString(),
+		Minimum:       data.Minimum.ValueFloat64(),
+		Maximum:       data.Maximum.ValueFloat64(),
+		IncrementOnly: data.IncrementOnly.ValueBool(),
+		SetAsGlobal:   data.SetAsGlobal.ValueBool(),
+		SetBy:         data.SetBy.ValueStringPointer(),
+		IsPublic:      data.IsPublic.ValueBool(),
+		Tags:          []string{},
+		CycleIds:      []string{},
+	}
+
+	diags.Append(data.Tags.ElementsAs(ctx, &stat.Tags, false)...)
+	if !data.CycleIds.IsUnknown() {
+		diags.Append(data.CycleIds.ElementsAs(ctx, &stat.CycleIds, false)...)
+	}
+
+	return stat, diags
+}
+
+// Used by the Update operation on statistics.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Only a subset of the statistic's fields can be changed after creation.
+func toApiStatisticUpdate(ctx context.Context, data AccelByteStatisticModel) (*socialclientmodels.StatUpdate, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	stat := &socialclientmodels.StatUpdate{
+		Name:         data.Name.ValueString(),
+		DefaultValue: data.DefaultValue.ValueFloat64(),
+		Description:  data.Description.ValueString(),
+		IsPublic:     data.IsPublic.ValueBool(),
+		Tags:         []string{},
+		CycleIds:     []string{},
+	}
+
+	diags.Append(data.Tags.ElementsAs(ctx, &stat.Tags, false)...)
+	if !data.CycleIds.IsUnknown() {
+		diags.Append(data.CycleIds.ElementsAs(ctx, &stat.CycleIds, false)...)
+	}
+
+	return stat, diags
+}
+
+// Used by the Create/Update operations on statistic cycles.
+// This takes the AccelByte API statistic `stat` and returns an AccelByte API update which removes the statistic from the cycle `cycleId`.
+// All other settings of the statistic are left unchanged.
+func toApiStatisticUpdateWithoutCycle(stat *socialclientmodels.StatInfo, cycleId string) *socialclientmodels.StatUpdate {
+
+	cycleIds := []string{}
+	for _, id := range stat.CycleIds {
+		if id != cycleId {
+			cycleIds = append(cycleIds, id)
+		}
+	}
+
+	cycleOverrides := []*socialclientmodels.CycleOverrideRequest{}
+	for _, cycleOverride := range stat.CycleOverrides {
+		if cycleOverride.CycleID != cycleId {
+			cycleOverrides = append(cycleOverrides, &socialclientmodels.CycleOverrideRequest{
+				CycleID: &cycleOverride.CycleID,
+				Maximum: cycleOverride.Maximum,
+				Minimum: cycleOverride.Minimum,
+			})
+		}
+	}
+
+	update := &socialclientmodels.StatUpdate{
+		Name:                                *stat.Name,
+		Description:                         stat.Description,
+		GlobalAggregationMethod:             stat.GlobalAggregationMethod,
+		IgnoreAdditionalDataOnValueRejected: stat.IgnoreAdditionalDataOnValueRejected != nil && *stat.IgnoreAdditionalDataOnValueRejected,
+		IsPublic:                            stat.IsPublic != nil && *stat.IsPublic,
+		Tags:                                stat.Tags,
+		Visibility:                          stat.Visibility,
+		CycleIds:                            cycleIds,
+		CycleOverrides:                      cycleOverrides,
+	}
+	if stat.DefaultValue != nil {
+		update.DefaultValue = *stat.DefaultValue
+	}
+
+	return update
+}
+
+func computeStatisticId(namespace string, statCode string) string {
+	return fmt.Sprintf("%s/%s", namespace, statCode)
+}
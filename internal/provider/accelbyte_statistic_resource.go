@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/social"
+	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclient/stat_configuration"
+	"github.com/AccelByte/accelbyte-go-sdk/social-sdk/pkg/socialclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteStatisticResource{}
+var _ resource.ResourceWithImportState = &AccelByteStatisticResource{}
+
+func NewAccelByteStatisticResource() resource.Resource {
+	return &AccelByteStatisticResource{}
+}
+
+// AccelByteStatisticResource defines the resource implementation.
+type AccelByteStatisticResource struct {
+	client *social.StatConfigurationService
+}
+
+func (r *AccelByteStatisticResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statistic"
+}
+
+func (r *AccelByteStatisticResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents a [statistic configuration](https://docs.accelbyte.io/gaming-services/services/storage/statistics/). Statistics track player values such as MMR or level, and can be referenced by matchmaking rulesets and leaderboards.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the statistic. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"stat_code": schema.StringAttribute{
+				MarkdownDescription: "Code of the statistic. Lowercase characters, digits, or dashes. Changing this forces the statistic to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`), "must consist of lowercase characters or digits, optionally separated by single dashes"),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Statistic identifier, on the format `{{namespace}}/{{stat_code}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Must be set by user during resource creation
+
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the statistic.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_value": schema.Float64Attribute{
+				MarkdownDescription: "Initial value of the statistic for each player. The API cannot change the default value to `0` after creation; doing so forces the statistic to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.Float64Request, resp *float64planmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !req.PlanValue.IsUnknown() && req.PlanValue.ValueFloat64() == 0
+						},
+						"Changing the default value to 0 forces the statistic to be re-created.",
+						"Changing the default value to `0` forces the statistic to be re-created.",
+					),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the statistic.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"minimum": schema.Float64Attribute{
+				MarkdownDescription: "Minimum value of the statistic. If not set, there is no lower bound. Changing this forces the statistic to be re-created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"maximum": schema.Float64Attribute{
+				MarkdownDescription: "Maximum value of the statistic. If not set, there is no upper bound. Changing this forces the statistic to be re-created.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"increment_only": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, the statistic can only be incremented. Changing this forces the statistic to be re-created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"set_as_global": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, changes to each player's statistic are also aggregated into a global statistic. Changing this forces the statistic to be re-created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"set_by": schema.StringAttribute{
+				MarkdownDescription: "Who is allowed to update the statistic. Either `CLIENT` or `SERVER`. Changing this forces the statistic to be re-created.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(socialclientmodels.StatCreateSetBySERVER),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(socialclientmodels.StatCreateSetByCLIENT, socialclientmodels.StatCreateSetBySERVER),
+				},
+			},
+			"is_public": schema.BoolAttribute{
+				MarkdownDescription: "If set to `true`, players' values for this statistic can be read by other players.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Tags for the statistic.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"cycle_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the statistic cycles that this statistic is part of. If not set, cycle membership is not managed by this resource; use this when membership is managed via `accelbyte_statistic_cycle.stat_codes` instead.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Computed during Read() operation
+
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the statistic. `TIED` if the statistic is in use, for example by a leaderboard; `INIT` otherwise.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *AccelByteStatisticResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.SocialStatConfigurationService
+}
+
+func (r *AccelByteStatisticResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteStatisticModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiStat, apiStatDiags := toApiStatisticCreate(ctx, data)
+	resp.Diagnostics.Append(apiStatDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating statistic via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"statCode":  data.StatCode,
+	})
+
+	createInput := &stat_configuration.CreateStatParams{
+		Namespace: data.Namespace.ValueString(),
+		Body:      apiStat,
+	}
+
+	stat, err := r.client.CreateStatShort(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating statistic via AccelByte API", fmt.Sprintf("Unable to create statistic '%s' in namespace '%s', got error: %s", data.StatCode.ValueString(), createInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(updateFromApiStatistic(ctx, &data, stat)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteStatisticResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteStatisticModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &stat_configuration.GetStatParams{
+		Namespace: data.Namespace.ValueString(),
+		StatCode:  data.StatCode.ValueString(),
+	}
+
+	stat, err := r.client.GetStatShort(input)
+	if err != nil {
+		notFoundError := &stat_configuration.GetStatNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading statistic via AccelByte API", fmt.Sprintf("Unable to read statistic '%s' in namespace '%s', got error: %s", input.StatCode, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read statistic from AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"statCode":  data.StatCode,
+		"stat":      stat,
+	})
+
+	resp.Diagnostics.Append(updateFromApiStatistic(ctx, &data, stat)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteStatisticResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteStatisticModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiStat, apiStatDiags := toApiStatisticUpdate(ctx, data)
+	resp.Diagnostics.Append(apiStatDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating statistic via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"statCode":  data.StatCode,
+	})
+
+	input := &stat_configuration.UpdateStatParams{
+		Namespace: data.Namespace.ValueString(),
+		StatCode:  data.StatCode.ValueString(),
+		Body:      apiStat,
+	}
+
+	stat, err := r.client.UpdateStatShort(input)
+	if err != nil {
+		notFoundError := &stat_configuration.UpdateStatNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Statistic '%s' does not exist in namespace '%s'", input.StatCode, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating statistic via AccelByte API", fmt.Sprintf("Unable to update statistic '%s' in namespace '%s', got error: %s", input.StatCode, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(updateFromApiStatistic(ctx, &data, stat)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteStatisticResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteStatisticModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting statistic via AccelByte API", map[string]interface{}{
+		"namespace": data.Namespace,
+		"statCode":  data.StatCode,
+	})
+
+	input := &stat_configuration.DeleteStatParams{
+		Namespace: data.Namespace.ValueString(),
+		StatCode:  data.StatCode.ValueString(),
+	}
+	err := r.client.DeleteStatShort(input)
+	if err != nil {
+		notFoundError := &stat_configuration.DeleteStatNotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting statistic via AccelByte API", fmt.Sprintf("Unable to delete statistic '%s' in namespace '%s', got error: %s", input.StatCode, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteStatisticResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "stat_code")
+}
@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AccelByteThirdPartyLoginPlatformModel is used by AccelByteThirdPartyLoginPlatformResource.
+type AccelByteThirdPartyLoginPlatformModel struct {
+	// Populated by user
+	Namespace  types.String `tfsdk:"namespace"`
+	PlatformId types.String `tfsdk:"platform_id"`
+
+	// Computed during Create() operation
+	Id types.String `tfsdk:"id"`
+
+	// Can be set by user during resource creation; will otherwise get defaults from the schema or from the API
+	PlatformName            types.String `tfsdk:"platform_name"`
+	IsActive                types.Bool   `tfsdk:"is_active"`
+	ClientId                types.String `tfsdk:"client_id"`
+	AppId                   types.String `tfsdk:"app_id"`
+	Secret                  types.String `tfsdk:"secret"`
+	RedirectUri             types.String `tfsdk:"redirect_uri"`
+	Environment             types.String `tfsdk:"environment"`
+	TeamId                  types.String `tfsdk:"team_id"`
+	KeyId                   types.String `tfsdk:"key_id"`
+	OrganizationId          types.String `tfsdk:"organization_id"`
+	TokenAuthenticationType types.String `tfsdk:"token_authentication_type"`
+	AllowedClients          types.List   `tfsdk:"allowed_clients"`    // []string
+	RegisteredDomains       types.Set    `tfsdk:"registered_domains"` // []AccelByteThirdPartyLoginPlatformDomainModel
+}
+
+var AccelByteThirdPartyLoginPlatformDomainModelAttributeTypes = map[string]attr.Type{
+	"domain":              types.StringType,
+	"role_id":             types.StringType,
+	"affected_client_ids": types.ListType{ElemType: types.StringType},
+	"namespaces":          types.ListType{ElemType: types.StringType},
+}
+
+type AccelByteThirdPartyLoginPlatformDomainModel struct {
+	Domain            types.String `tfsdk:"domain"`
+	RoleId            types.String `tfsdk:"role_id"`
+	AffectedClientIds types.List   `tfsdk:"affected_client_ids"` // []string
+	Namespaces        types.List   `tfsdk:"namespaces"`          // []string
+}
+
+// Used by Create, Read and Update operations on third-party login platforms.
+// This copies data from the AccelByte API `credential` to the TF state `data`.
+// The secret is not copied; the TF state keeps the value that was last written.
+func updateFromApiThirdPartyLoginPlatform(ctx context.Context, data *AccelByteThirdPartyLoginPlatformModel, credential *iamclientmodels.ModelThirdPartyLoginPlatformCredentialResponse) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	data.PlatformId = types.StringValue(*credential.PlatformID)
+	data.Id = types.StringValue(computeThirdPartyLoginPlatformId(data.Namespace.ValueString(), *credential.PlatformID))
+
+	data.PlatformName = types.StringPointerValue(credential.PlatformName)
+	data.IsActive = types.BoolValue(credential.IsActive != nil && *credential.IsActive)
+	data.ClientId = types.StringPointerValue(credential.ClientID)
+	data.AppId = types.StringPointerValue(credential.AppID)
+	data.RedirectUri = types.StringPointerValue(credential.RedirectURI)
+	data.Environment = types.StringPointerValue(credential.Environment)
+	data.TeamId = types.StringPointerValue(credential.TeamID)
+	data.KeyId = types.StringPointerValue(credential.KeyID)
+	data.OrganizationId = types.StringPointerValue(credential.OrganizationID)
+	data.TokenAuthenticationType = types.StringPointerValue(credential.TokenAuthenticationType)
+
+	allowedClients, allowedClientsDiags := listValueFromEvenIfNil(ctx, types.StringType, credential.AllowedClients)
+	data.AllowedClients = allowedClients
+	diags.Append(allowedClientsDiags...)
+
+	// Optional domain settings that are not in use are reflected as nulls in the state
+	domains := []AccelByteThirdPartyLoginPlatformDomainModel{}
+	for _, domain := range credential.RegisteredDomains {
+		domainModel := AccelByteThirdPartyLoginPlatformDomainModel{
+			Domain:            types.StringValue(*domain.Domain),
+			RoleId:            types.StringNull(),
+			AffectedClientIds: types.ListNull(types.StringType),
+			Namespaces:        types.ListNull(types.StringType),
+		}
+		if domain.RoleID != nil && *domain.RoleID != "" {
+			domainModel.RoleId = types.StringValue(*domain.RoleID)
+		}
+		if len(domain.AffectedClientIDs) > 0 {
+			affectedClientIds, affectedClientIdsDiags := types.ListValueFrom(ctx, types.StringType, domain.AffectedClientIDs)
+			domainModel.AffectedClientIds = affectedClientIds
+			diags.Append(affectedClientIdsDiags...)
+		}
+		if len(domain.Namespaces) > 0 {
+			namespaces, namespacesDiags := types.ListValueFrom(ctx, types.StringType, domain.Namespaces)
+			domainModel.Namespaces = namespaces
+			diags.Append(namespacesDiags...)
+		}
+		domains = append(domains, domainModel)
+	}
+	domainsSet, domainsDiags := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: AccelByteThirdPartyLoginPlatformDomainModelAttributeTypes}, domains)
+	data.RegisteredDomains = domainsSet
+	diags.Append(domainsDiags...)
+
+	// Fields that the API never populates are returned as nulls; reflect them as empty strings in the state
+	for _, field := range []*types.String{&data.PlatformName, &data.ClientId, &data.AppId, &data.RedirectUri, &data.Environment, &data.TeamId, &data.KeyId, &data.OrganizationId, &data.TokenAuthenticationType} {
+		if field.IsNull() {
+			*field = types.StringValue("")
+		}
+	}
+
+	return diags
+}
+
+// Used by Create/Update operations on third-party login platforms.
+// This reads from the TF state `data` and returns an AccelByte API object.
+// Registered domains are not included; these are managed via separate API calls.
+func toApiThirdPartyLoginPlatformCredentialRequest(ctx context.Context, data AccelByteThirdPartyLoginPlatformModel) (*iamclientmodels.ModelThirdPartyLoginPlatformCredentialRequest, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	platformName := data.PlatformName
+	if platformName.IsUnknown() || platformName.IsNull() {
+		platformName = data.PlatformId
+	}
+
+	emptyString := ""
+
+	request := &iamclientmodels.ModelThirdPartyLoginPlatformCredentialRequest{
+		PlatformName:            platformName.ValueStringPointer(),
+		IsActive:                data.IsActive.ValueBoolPointer(),
+		ClientID:                data.ClientId.ValueStringPointer(),
+		AppID:                   data.AppId.ValueStringPointer(),
+		RedirectURI:             data.RedirectUri.ValueStringPointer(),
+		Environment:             data.Environment.ValueStringPointer(),
+		TeamID:                  data.TeamId.ValueStringPointer(),
+		KeyID:                   data.KeyId.ValueStringPointer(),
+		OrganizationID:          data.OrganizationId.ValueStringPointer(),
+		TokenAuthenticationType: data.TokenAuthenticationType.ValueStringPointer(),
+		Secret:                  data.Secret.ValueStringPointer(),
+
+		// These fields are only used by generic OIDC / SAML platforms, but the API requires them to be present
+		ACSURL:                &emptyString,
+		AWSCognitoRegion:      &emptyString,
+		AWSCognitoUserPool:    &emptyString,
+		AuthorizationEndpoint: &emptyString,
+		FederationMetadataURL: &emptyString,
+		Issuer:                &emptyString,
+		JWKSEndpoint:          &emptyString,
+		TokenEndpoint:         &emptyString,
+		UserInfoEndpoint:      &emptyString,
+		UserInfoHTTPMethod:    &emptyString,
+		GenericOauthFlow:      new(bool),
+		RegisteredDomains:     []*iamclientmodels.AccountcommonRegisteredDomain{},
+		Scopes:                []string{},
+	}
+
+	diags.Append(data.AllowedClients.ElementsAs(ctx, &request.AllowedClients, false)...)
+
+	return request, diags
+}
+
+// Used by Create/Update operations on third-party login platforms.
+// This reads the registered domains from the TF state `data` and returns them keyed by domain.
+func toApiThirdPartyLoginPlatformDomains(ctx context.Context, data AccelByteThirdPartyLoginPlatformModel) (map[string]*iamclientmodels.ModelPlatformDomainUpdateRequest, diag.Diagnostics) {
+
+	var diags diag.Diagnostics = nil
+
+	domains := []AccelByteThirdPartyLoginPlatformDomainModel{}
+	diags.Append(data.RegisteredDomains.ElementsAs(ctx, &domains, false)...)
+
+	apiDomains := map[string]*iamclientmodels.ModelPlatformDomainUpdateRequest{}
+	for _, domain := range domains {
+		roleId := domain.RoleId.ValueString()
+		apiDomain := &iamclientmodels.ModelPlatformDomainUpdateRequest{
+			Domain:             domain.Domain.ValueStringPointer(),
+			RoleID:             &roleId,
+			AffectedClientIDs:  []string{},
+			AssignedNamespaces: []string{},
+		}
+		if !domain.AffectedClientIds.IsNull() {
+			diags.Append(domain.AffectedClientIds.ElementsAs(ctx, &apiDomain.AffectedClientIDs, false)...)
+		}
+		if !domain.Namespaces.IsNull() {
+			diags.Append(domain.Namespaces.ElementsAs(ctx, &apiDomain.AssignedNamespaces, false)...)
+		}
+		apiDomains[domain.Domain.ValueString()] = apiDomain
+	}
+
+	return apiDomains, diags
+}
+
+func computeThirdPartyLoginPlatformId(namespace string, platformId string) string {
+	return fmt.Sprintf("%s/%s", namespace, platformId)
+}
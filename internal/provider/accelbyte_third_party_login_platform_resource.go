@@ -0,0 +1,535 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclient/third_party_credential"
+	"github.com/AccelByte/accelbyte-go-sdk/iam-sdk/pkg/iamclientmodels"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccelByteThirdPartyLoginPlatformResource{}
+var _ resource.ResourceWithImportState = &AccelByteThirdPartyLoginPlatformResource{}
+var _ resource.ResourceWithValidateConfig = &AccelByteThirdPartyLoginPlatformResource{}
+
+// Attributes that must be set for each of the well-known platforms.
+// Platforms that are not listed here (for example generic OIDC platforms) are not validated.
+var thirdPartyLoginPlatformRequiredAttributes = map[string][]string{
+	"steam":       {"app_id", "secret"},
+	"steamopenid": {"app_id", "secret", "redirect_uri"},
+	"epicgames":   {"client_id", "secret"},
+	"ps4":         {"client_id", "secret", "redirect_uri", "environment"},
+	"ps4web":      {"client_id", "secret", "redirect_uri", "environment"},
+	"ps5":         {"client_id", "secret", "redirect_uri", "environment"},
+	"xblweb":      {"client_id", "secret", "redirect_uri"},
+	"google":      {"client_id", "secret", "redirect_uri"},
+	"apple":       {"client_id", "team_id", "key_id", "secret", "redirect_uri"},
+	"discord":     {"client_id", "secret", "redirect_uri"},
+}
+
+// Valid values for `environment` on PlayStation Network platforms.
+var thirdPartyLoginPlatformPsnEnvironments = []string{"sp-int", "prod-qa", "np"}
+
+func NewAccelByteThirdPartyLoginPlatformResource() resource.Resource {
+	return &AccelByteThirdPartyLoginPlatformResource{}
+}
+
+// AccelByteThirdPartyLoginPlatformResource defines the resource implementation.
+type AccelByteThirdPartyLoginPlatformResource struct {
+	client *iam.ThirdPartyCredentialService
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_third_party_login_platform"
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "This resource represents the credentials for a [third-party login platform](https://docs.accelbyte.io/gaming-services/services/access/authentication/) such as Steam, Epic Games, PlayStation Network, Xbox, Google, Apple or Discord.",
+
+		Attributes: map[string]schema.Attribute{
+
+			// Must be set by user; the ID is derived from these
+
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Game Namespace which contains the login platform configuration. Uppercase characters, lowercase characters, or digits. Max 64 characters in length.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"platform_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the login platform, for example `steam`, `epicgames`, `ps5`, `xblweb`, `google`, `apple` or `discord`. Changing this forces the configuration to be re-created.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Computed during Create() operation
+
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Login platform identifier, on the format `{{namespace}}/{{platform_id}}`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+
+			// Can be set by user during resource creation; will otherwise get defaults from schema
+
+			"platform_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the login platform. Defaults to `platform_id`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "If set to `false`, players cannot log in via this platform.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID issued by the platform. Required for `epicgames`, `ps4`, `ps4web`, `ps5`, `xblweb`, `google`, `apple` and `discord`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "App ID issued by the platform. Required for `steam` and `steamopenid`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"secret": schema.StringAttribute{
+				MarkdownDescription: "Secret issued by the platform; for example the Web API key for `steam`, or the private key for `apple`. The AccelByte API does not reliably return secrets, so this value is never read back; changes made outside of Terraform will not be detected. Required for `steam`, `steamopenid`, `epicgames`, `ps4`, `ps4web`, `ps5`, `xblweb`, `google`, `apple` and `discord`. Every update sends this value, so for other platforms leaving it unset clears any existing secret.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"redirect_uri": schema.StringAttribute{
+				MarkdownDescription: "Redirect URI used during the platform's OAuth flow.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Platform environment. For PlayStation Network platforms, one of `sp-int`, `prod-qa` or `np`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Team ID. Required for `apple`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"key_id": schema.StringAttribute{
+				MarkdownDescription: "Key ID. Required for `apple`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "Organization ID, for platforms that use one.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"token_authentication_type": schema.StringAttribute{
+				MarkdownDescription: "How tokens from the platform are authenticated, for platforms that support several methods.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"allowed_clients": schema.ListAttribute{
+				MarkdownDescription: "IAM client IDs that are allowed to log in via this platform. If empty, all clients are allowed.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"registered_domains": schema.SetNestedAttribute{
+				MarkdownDescription: "Email domains with special handling. Users logging in via this platform with an email address in one of these domains will be granted the specified role.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "Email domain, for example `example.com`.",
+							Required:            true,
+						},
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the IAM role to grant to users from this domain.",
+							Optional:            true,
+						},
+						"affected_client_ids": schema.ListAttribute{
+							MarkdownDescription: "IAM client IDs for which the domain settings apply.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+						"namespaces": schema.ListAttribute{
+							MarkdownDescription: "Namespaces in which the role is granted.",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+				Optional: true,
+				Computed: true,
+				Default:  setdefault.StaticValue(types.SetValueMust(types.ObjectType{AttrTypes: AccelByteThirdPartyLoginPlatformDomainModelAttributeTypes}, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data AccelByteThirdPartyLoginPlatformModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.PlatformId.IsUnknown() || data.PlatformId.IsNull() {
+		return
+	}
+
+	platformId := data.PlatformId.ValueString()
+
+	attributeValues := map[string]types.String{
+		"client_id":    data.ClientId,
+		"app_id":       data.AppId,
+		"secret":       data.Secret,
+		"redirect_uri": data.RedirectUri,
+		"environment":  data.Environment,
+		"team_id":      data.TeamId,
+		"key_id":       data.KeyId,
+	}
+
+	for _, attributeName := range thirdPartyLoginPlatformRequiredAttributes[platformId] {
+		value := attributeValues[attributeName]
+		if value.IsUnknown() {
+			continue
+		}
+		if value.IsNull() || value.ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attributeName),
+				"Missing required attribute",
+				fmt.Sprintf("Attribute `%s` must be set for login platform '%s'", attributeName, platformId),
+			)
+		}
+	}
+
+	if platformId == "ps4" || platformId == "ps4web" || platformId == "ps5" {
+		if !data.Environment.IsUnknown() && !data.Environment.IsNull() && data.Environment.ValueString() != "" && !slices.Contains(thirdPartyLoginPlatformPsnEnvironments, data.Environment.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("environment"),
+				"Invalid attribute value",
+				fmt.Sprintf("Attribute `environment` must be one of %v for login platform '%s', got: '%s'", thirdPartyLoginPlatformPsnEnvironments, platformId, data.Environment.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*AccelByteProviderClients)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AccelByteProviderClients, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = clients.IamThirdPartyCredentialService
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccelByteThirdPartyLoginPlatformModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRequest, apiRequestDiags := toApiThirdPartyLoginPlatformCredentialRequest(ctx, data)
+	resp.Diagnostics.Append(apiRequestDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Creating third-party login platform via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"platformId": data.PlatformId,
+	})
+
+	createInput := &third_party_credential.AddThirdPartyLoginPlatformCredentialV3Params{
+		Namespace:  data.Namespace.ValueString(),
+		PlatformID: data.PlatformId.ValueString(),
+		Body:       apiRequest,
+	}
+
+	credential, err := r.client.AddThirdPartyLoginPlatformCredentialV3Short(createInput)
+	if err != nil {
+		resp.Diagnostics.AddError("Error when creating third-party login platform via AccelByte API", fmt.Sprintf("Unable to create third-party login platform '%s' in namespace '%s', got error: %s", createInput.PlatformID, createInput.Namespace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyDomains(ctx, data, credential.RegisteredDomains)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccelByteThirdPartyLoginPlatformModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := &third_party_credential.RetrieveThirdPartyLoginPlatformCredentialV3Params{
+		Namespace:  data.Namespace.ValueString(),
+		PlatformID: data.PlatformId.ValueString(),
+	}
+
+	credential, err := r.client.RetrieveThirdPartyLoginPlatformCredentialV3Short(input)
+	if err != nil {
+		notFoundError := &third_party_credential.RetrieveThirdPartyLoginPlatformCredentialV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// Ensure that it does not exist in the Terraform state either
+			// This not an error condition; Terraform will proceed assuming that the resource does not exist in the backend
+			resp.State.RemoveResource(ctx)
+			return
+		} else {
+			// Failed to retrieve the resource from the AccelByte backend
+			// This is an actual error; do not update Terraform state, and signal an error to Terraform
+			resp.Diagnostics.AddError("Error when reading third-party login platform via AccelByte API", fmt.Sprintf("Unable to read third-party login platform '%s' in namespace '%s', got error: %s", input.PlatformID, input.Namespace, err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Read third-party login platform from AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"platformId": data.PlatformId,
+	})
+
+	resp.Diagnostics.Append(updateFromApiThirdPartyLoginPlatform(ctx, &data, credential)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AccelByteThirdPartyLoginPlatformModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiRequest, apiRequestDiags := toApiThirdPartyLoginPlatformCredentialRequest(ctx, data)
+	resp.Diagnostics.Append(apiRequestDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Updating third-party login platform via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"platformId": data.PlatformId,
+	})
+
+	input := &third_party_credential.UpdateThirdPartyLoginPlatformCredentialV3Params{
+		Namespace:  data.Namespace.ValueString(),
+		PlatformID: data.PlatformId.ValueString(),
+		Body:       apiRequest,
+	}
+
+	credential, err := r.client.UpdateThirdPartyLoginPlatformCredentialV3Short(input)
+	if err != nil {
+		notFoundError := &third_party_credential.UpdateThirdPartyLoginPlatformCredentialV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource does not exist in the AccelByte backend
+			// This means that the resource has disappeared since the TF state was refreshed at the start of the apply operation; we should abort
+			resp.Diagnostics.AddError("Resource not found", fmt.Sprintf("Third-party login platform '%s' does not exist in namespace '%s'", input.PlatformID, input.Namespace))
+			return
+		} else {
+			// Failed to update the resource in the AccelByte backend
+			// The backend refused our update operation; we should abort
+			resp.Diagnostics.AddError("Error when updating third-party login platform via AccelByte API", fmt.Sprintf("Unable to update third-party login platform '%s' in namespace '%s', got error: %s", input.PlatformID, input.Namespace, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.applyDomains(ctx, data, credential.RegisteredDomains)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &data)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccelByteThirdPartyLoginPlatformModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "Deleting third-party login platform via AccelByte API", map[string]interface{}{
+		"namespace":  data.Namespace,
+		"platformId": data.PlatformId,
+	})
+
+	input := &third_party_credential.DeleteThirdPartyLoginPlatformCredentialV3Params{
+		Namespace:  data.Namespace.ValueString(),
+		PlatformID: data.PlatformId.ValueString(),
+	}
+	err := r.client.DeleteThirdPartyLoginPlatformCredentialV3Short(input)
+	if err != nil {
+		notFoundError := &third_party_credential.DeleteThirdPartyLoginPlatformCredentialV3NotFound{}
+		if errors.As(err, &notFoundError) {
+			// The resource has already been deleted from the AccelByte backend
+			return
+		}
+		resp.Diagnostics.AddError("Error when deleting third-party login platform via AccelByte API", fmt.Sprintf("Unable to delete third-party login platform '%s' in namespace '%s', got error: %s", input.PlatformID, input.Namespace, err))
+		return
+	}
+}
+
+func (r *AccelByteThirdPartyLoginPlatformResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateFromCompositeId(ctx, req, resp, "namespace", "platform_id")
+}
+
+// Fetch the login platform configuration after a write operation, so we can get the values for un-set defaults
+func (r *AccelByteThirdPartyLoginPlatformResource) read(ctx context.Context, data *AccelByteThirdPartyLoginPlatformModel) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	input := &third_party_credential.RetrieveThirdPartyLoginPlatformCredentialV3Params{
+		Namespace:  data.Namespace.ValueString(),
+		PlatformID: data.PlatformId.ValueString(),
+	}
+
+	credential, err := r.client.RetrieveThirdPartyLoginPlatformCredentialV3Short(input)
+	if err != nil {
+		diags.AddError("Error when reading third-party login platform via AccelByte API", fmt.Sprintf("Unable to read third-party login platform '%s' in namespace '%s', got error: %s", input.PlatformID, input.Namespace, err))
+		return diags
+	}
+
+	diags.Append(updateFromApiThirdPartyLoginPlatform(ctx, data, credential)...)
+
+	return diags
+}
+
+// Add, update and remove registered domains, so that they match the TF plan `data`
+func (r *AccelByteThirdPartyLoginPlatformResource) applyDomains(ctx context.Context, data AccelByteThirdPartyLoginPlatformModel, currentDomains []*iamclientmodels.AccountcommonRegisteredDomain) diag.Diagnostics {
+
+	var diags diag.Diagnostics = nil
+
+	namespace := data.Namespace.ValueString()
+	platformId := data.PlatformId.ValueString()
+
+	desiredDomains, desiredDomainsDiags := toApiThirdPartyLoginPlatformDomains(ctx, data)
+	diags.Append(desiredDomainsDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	currentDomainsByName := map[string]*iamclientmodels.AccountcommonRegisteredDomain{}
+	for _, domain := range currentDomains {
+		currentDomainsByName[*domain.Domain] = domain
+	}
+
+	for domainName := range currentDomainsByName {
+		if _, ok := desiredDomains[domainName]; ok {
+			continue
+		}
+
+		domainName := domainName
+		err := r.client.DeleteThirdPartyLoginPlatformDomainV3Short(&third_party_credential.DeleteThirdPartyLoginPlatformDomainV3Params{
+			Namespace:  namespace,
+			PlatformID: platformId,
+			Body:       &iamclientmodels.ModelPlatformDomainDeleteRequest{Domain: &domainName},
+		})
+		if err != nil {
+			diags.AddError("Error when deleting third-party login platform domain via AccelByte API", fmt.Sprintf("Unable to delete domain '%s' from third-party login platform '%s' in namespace '%s', got error: %s", domainName, platformId, namespace, err))
+			return diags
+		}
+	}
+
+	for domainName, desiredDomain := range desiredDomains {
+		if currentDomain, ok := currentDomainsByName[domainName]; ok {
+			currentRoleId := ""
+			if currentDomain.RoleID != nil {
+				currentRoleId = *currentDomain.RoleID
+			}
+			if currentRoleId == *desiredDomain.RoleID && slices.Equal(currentDomain.AffectedClientIDs, desiredDomain.AffectedClientIDs) && slices.Equal(currentDomain.Namespaces, desiredDomain.AssignedNamespaces) {
+				continue
+			}
+		}
+
+		_, err := r.client.UpdateThirdPartyLoginPlatformDomainV3Short(&third_party_credential.UpdateThirdPartyLoginPlatformDomainV3Params{
+			Namespace:  namespace,
+			PlatformID: platformId,
+			Body:       desiredDomain,
+		})
+		if err != nil {
+			diags.AddError("Error when updating third-party login platform domain via AccelByte API", fmt.Sprintf("Unable to update domain '%s' for third-party login platform '%s' in namespace '%s', got error: %s", domainName, platformId, namespace, err))
+			return diags
+		}
+	}
+
+	return diags
+}
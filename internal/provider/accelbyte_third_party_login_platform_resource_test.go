@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestThirdPartyLoginPlatformValidateConfig(t *testing.T) {
+	r := NewAccelByteThirdPartyLoginPlatformResource().(*AccelByteThirdPartyLoginPlatformResource)
+	s := testResourceSchema(t, r)
+
+	str := func(value string) tftypes.Value { return tftypes.NewValue(tftypes.String, value) }
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+
+	tests := []struct {
+		name       string
+		values     map[string]tftypes.Value
+		wantErrors []string // attributes with errors
+	}{
+		{
+			name:   "steam with all required attributes",
+			values: map[string]tftypes.Value{"platform_id": str("steam"), "app_id": str("123"), "secret": str("key")},
+		},
+		{
+			name:       "steam without secret",
+			values:     map[string]tftypes.Value{"platform_id": str("steam"), "app_id": str("123")},
+			wantErrors: []string{"secret"},
+		},
+		{
+			name:       "steam with empty app ID",
+			values:     map[string]tftypes.Value{"platform_id": str("steam"), "app_id": str(""), "secret": str("key")},
+			wantErrors: []string{"app_id"},
+		},
+		{
+			name:   "unknown values are not checked",
+			values: map[string]tftypes.Value{"platform_id": str("steam"), "app_id": unknown, "secret": unknown},
+		},
+		{
+			name:       "apple without team ID and key ID",
+			values:     map[string]tftypes.Value{"platform_id": str("apple"), "client_id": str("com.example"), "secret": str("key"), "redirect_uri": str("https://example.com")},
+			wantErrors: []string{"team_id", "key_id"},
+		},
+		{
+			name:   "ps5 with valid environment",
+			values: map[string]tftypes.Value{"platform_id": str("ps5"), "client_id": str("id"), "secret": str("key"), "redirect_uri": str("https://example.com"), "environment": str("np")},
+		},
+		{
+			name:       "ps5 with invalid environment",
+			values:     map[string]tftypes.Value{"platform_id": str("ps5"), "client_id": str("id"), "secret": str("key"), "redirect_uri": str("https://example.com"), "environment": str("live")},
+			wantErrors: []string{"environment"},
+		},
+		{
+			name:   "unlisted platform is not validated",
+			values: map[string]tftypes.Value{"platform_id": str("generic")},
+		},
+		{
+			name:   "unknown platform is not validated",
+			values: map[string]tftypes.Value{"platform_id": unknown},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: testObjectValue(t, s, test.values)},
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			r.ValidateConfig(context.Background(), req, resp)
+
+			if len(resp.Diagnostics.Errors()) != len(test.wantErrors) {
+				t.Fatalf("got diagnostics %v, want errors for %v", resp.Diagnostics, test.wantErrors)
+			}
+			for _, attributeName := range test.wantErrors {
+				found := false
+				for _, diagnostic := range resp.Diagnostics.Errors() {
+					if withPath, ok := diagnostic.(interface{ Path() path.Path }); ok && withPath.Path().Equal(path.Root(attributeName)) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("got diagnostics %v, want an error for attribute '%s'", resp.Diagnostics, attributeName)
+				}
+			}
+		})
+	}
+}
@@ -5,12 +5,28 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/factory"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/achievement"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/ams"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/basic"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/chat"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/cloudsave"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/csm"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/group"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/iam"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/inventory"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/leaderboard"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/legal"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/lobby"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/match2"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/platform"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/reporting"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/session"
+	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/service/social"
 	"github.com/AccelByte/accelbyte-go-sdk/services-api/pkg/utils/auth"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -45,9 +61,46 @@ type AccelByteProviderModel struct {
 }
 
 type AccelByteProviderClients struct {
-	Match2PoolsService                  *match2.MatchPoolsService
-	RuleSetsService                     *match2.RuleSetsService
-	SessionConfigurationTemplateService *session.ConfigurationTemplateService
+	AchievementsService                              *achievement.AchievementsService
+	AmsFleetsService                                 *ams.FleetsService
+	AmsImagesService                                 *ams.ImagesService
+	AmsInfoService                                   *ams.AMSInfoService
+	BasicNamespaceService                            *basic.NamespaceService
+	ChatConfigService                                *chat.ConfigService
+	ChatProfanityService                             *chat.ProfanityService
+	CloudsaveAdminConcurrentRecordService            *cloudsave.AdminConcurrentRecordService
+	CloudsaveAdminGameRecordService                  *cloudsave.AdminGameRecordService
+	CloudsaveAdminRecordService                      *cloudsave.AdminRecordService
+	CsmAppV2Service                                  *csm.AppV2Service
+	CsmConfigurationV2Service                        *csm.ConfigurationV2Service
+	GroupConfigurationService                        *group.ConfigurationService
+	GroupRolesService                                *group.GroupRolesService
+	IamClientsService                                *iam.ClientsService
+	IamRolesService                                  *iam.RolesService
+	IamThirdPartyCredentialService                   *iam.ThirdPartyCredentialService
+	InventoryAdminInventoryConfigurationsService     *inventory.AdminInventoryConfigurationsService
+	InventoryAdminItemTypesService                   *inventory.AdminItemTypesService
+	LeaderboardConfigurationService                  *leaderboard.LeaderboardConfigurationService
+	LegalBaseLegalPoliciesWithNamespaceService       *legal.BaseLegalPoliciesWithNamespaceService
+	LegalLocalizedPolicyVersionsWithNamespaceService *legal.LocalizedPolicyVersionsWithNamespaceService
+	LegalPolicyVersionsWithNamespaceService          *legal.PolicyVersionsWithNamespaceService
+	LobbyConfigService                               *lobby.ConfigService
+	Match2PoolsService                               *match2.MatchPoolsService
+	PlatformCampaignService                          *platform.CampaignService
+	PlatformCatalogChangesService                    *platform.CatalogChangesService
+	PlatformCategoryService                          *platform.CategoryService
+	PlatformCurrencyService                          *platform.CurrencyService
+	PlatformIAPService                               *platform.IAPService
+	PlatformItemService                              *platform.ItemService
+	PlatformRewardService                            *platform.RewardService
+	PlatformStoreService                             *platform.StoreService
+	ReportingAdminConfigurationsService              *reporting.AdminConfigurationsService
+	ReportingAdminModerationRuleService              *reporting.AdminModerationRuleService
+	ReportingAdminReasonsService                     *reporting.AdminReasonsService
+	RuleSetsService                                  *match2.RuleSetsService
+	SessionConfigurationTemplateService              *session.ConfigurationTemplateService
+	SocialStatConfigurationService                   *social.StatConfigurationService
+	SocialStatCycleConfigurationService              *social.StatCycleConfigurationService
 }
 
 func (p *AccelByteProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -274,11 +327,216 @@ func (p *AccelByteProvider) Configure(ctx context.Context, req provider.Configur
 
 	// Set up service entry points, that will be used by resources & data sources
 
+	achievementsService := &achievement.AchievementsService{
+		Client:           factory.NewAchievementClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	amsFleetsService := &ams.FleetsService{
+		Client:          factory.NewAmsClient(&configRepository),
+		TokenRepository: tokenRepository,
+	}
+
+	amsImagesService := &ams.ImagesService{
+		Client:          factory.NewAmsClient(&configRepository),
+		TokenRepository: tokenRepository,
+	}
+
+	amsInfoService := &ams.AMSInfoService{
+		Client:          factory.NewAmsClient(&configRepository),
+		TokenRepository: tokenRepository,
+	}
+
+	basicNamespaceService := &basic.NamespaceService{
+		Client:           factory.NewBasicClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	chatConfigService := &chat.ConfigService{
+		Client:           factory.NewChatClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	chatProfanityService := &chat.ProfanityService{
+		Client:           factory.NewChatClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	cloudsaveAdminConcurrentRecordService := &cloudsave.AdminConcurrentRecordService{
+		Client:           factory.NewCloudsaveClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	cloudsaveAdminGameRecordService := &cloudsave.AdminGameRecordService{
+		Client:           factory.NewCloudsaveClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	cloudsaveAdminRecordService := &cloudsave.AdminRecordService{
+		Client:           factory.NewCloudsaveClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	csmAppV2Service := &csm.AppV2Service{
+		Client:          factory.NewCsmClient(&configRepository),
+		TokenRepository: tokenRepository,
+	}
+
+	csmConfigurationV2Service := &csm.ConfigurationV2Service{
+		Client:          factory.NewCsmClient(&configRepository),
+		TokenRepository: tokenRepository,
+	}
+
+	groupConfigurationService := &group.ConfigurationService{
+		Client:           factory.NewGroupClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	groupRolesService := &group.GroupRolesService{
+		Client:           factory.NewGroupClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	iamClientsService := &iam.ClientsService{
+		Client:           factory.NewIamClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	iamRolesService := &iam.RolesService{
+		Client:           factory.NewIamClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	iamThirdPartyCredentialService := &iam.ThirdPartyCredentialService{
+		Client:           factory.NewIamClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	inventoryAdminInventoryConfigurationsService := &inventory.AdminInventoryConfigurationsService{
+		Client:           factory.NewInventoryClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	inventoryAdminItemTypesService := &inventory.AdminItemTypesService{
+		Client:           factory.NewInventoryClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	leaderboardConfigurationService := &leaderboard.LeaderboardConfigurationService{
+		Client:           factory.NewLeaderboardClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	legalBaseLegalPoliciesWithNamespaceService := &legal.BaseLegalPoliciesWithNamespaceService{
+		Client:           factory.NewLegalClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	legalLocalizedPolicyVersionsWithNamespaceService := &legal.LocalizedPolicyVersionsWithNamespaceService{
+		Client:           factory.NewLegalClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	legalPolicyVersionsWithNamespaceService := &legal.PolicyVersionsWithNamespaceService{
+		Client:           factory.NewLegalClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	lobbyConfigService := &lobby.ConfigService{
+		Client:           factory.NewLobbyClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
 	match2PoolsService := &match2.MatchPoolsService{
 		Client:          factory.NewMatch2Client(&configRepository),
 		TokenRepository: tokenRepository,
 	}
 
+	platformCampaignService := &platform.CampaignService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	platformCatalogChangesService := &platform.CatalogChangesService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	platformCategoryService := &platform.CategoryService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	platformCurrencyService := &platform.CurrencyService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	platformIAPService := &platform.IAPService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	platformItemService := &platform.ItemService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	platformRewardService := &platform.RewardService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	platformStoreService := &platform.StoreService{
+		Client:           factory.NewPlatformClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	reportingAdminConfigurationsService := &reporting.AdminConfigurationsService{
+		Client:           factory.NewReportingClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	reportingAdminModerationRuleService := &reporting.AdminModerationRuleService{
+		Client:           factory.NewReportingClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	reportingAdminReasonsService := &reporting.AdminReasonsService{
+		Client:           factory.NewReportingClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
 	ruleSetsService := &match2.RuleSetsService{
 		Client:          factory.NewMatch2Client(&configRepository),
 		TokenRepository: tokenRepository,
@@ -289,10 +547,59 @@ func (p *AccelByteProvider) Configure(ctx context.Context, req provider.Configur
 		TokenRepository: tokenRepository,
 	}
 
+	socialStatConfigurationService := &social.StatConfigurationService{
+		Client:           factory.NewSocialClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
+	socialStatCycleConfigurationService := &social.StatCycleConfigurationService{
+		Client:           factory.NewSocialClient(&configRepository),
+		ConfigRepository: &configRepository,
+		TokenRepository:  tokenRepository,
+	}
+
 	clients := &AccelByteProviderClients{
-		Match2PoolsService:                  match2PoolsService,
-		RuleSetsService:                     ruleSetsService,
-		SessionConfigurationTemplateService: sessionConfigurationTemplateService,
+		AchievementsService:                              achievementsService,
+		AmsFleetsService:                                 amsFleetsService,
+		AmsImagesService:                                 amsImagesService,
+		AmsInfoService:                                   amsInfoService,
+		BasicNamespaceService:                            basicNamespaceService,
+		ChatConfigService:                                chatConfigService,
+		ChatProfanityService:                             chatProfanityService,
+		CloudsaveAdminConcurrentRecordService:            cloudsaveAdminConcurrentRecordService,
+		CloudsaveAdminGameRecordService:                  cloudsaveAdminGameRecordService,
+		CloudsaveAdminRecordService:                      cloudsaveAdminRecordService,
+		CsmAppV2Service:                                  csmAppV2Service,
+		CsmConfigurationV2Service:                        csmConfigurationV2Service,
+		GroupConfigurationService:                        groupConfigurationService,
+		GroupRolesService:                                groupRolesService,
+		IamClientsService:                                iamClientsService,
+		IamRolesService:                                  iamRolesService,
+		IamThirdPartyCredentialService:                   iamThirdPartyCredentialService,
+		InventoryAdminInventoryConfigurationsService:     inventoryAdminInventoryConfigurationsService,
+		InventoryAdminItemTypesService:                   inventoryAdminItemTypesService,
+		LeaderboardConfigurationService:                  leaderboardConfigurationService,
+		LegalBaseLegalPoliciesWithNamespaceService:       legalBaseLegalPoliciesWithNamespaceService,
+		LegalLocalizedPolicyVersionsWithNamespaceService: legalLocalizedPolicyVersionsWithNamespaceService,
+		LegalPolicyVersionsWithNamespaceService:          legalPolicyVersionsWithNamespaceService,
+		LobbyConfigService:                               lobbyConfigService,
+		Match2PoolsService:                               match2PoolsService,
+		PlatformCampaignService:                          platformCampaignService,
+		PlatformCatalogChangesService:                    platformCatalogChangesService,
+		PlatformCategoryService:                          platformCategoryService,
+		PlatformCurrencyService:                          platformCurrencyService,
+		PlatformIAPService:                               platformIAPService,
+		PlatformItemService:                              platformItemService,
+		PlatformRewardService:                            platformRewardService,
+		PlatformStoreService:                             platformStoreService,
+		ReportingAdminConfigurationsService:              reportingAdminConfigurationsService,
+		ReportingAdminModerationRuleService:              reportingAdminModerationRuleService,
+		ReportingAdminReasonsService:                     reportingAdminReasonsService,
+		RuleSetsService:                                  ruleSetsService,
+		SessionConfigurationTemplateService:              sessionConfigurationTemplateService,
+		SocialStatConfigurationService:                   socialStatConfigurationService,
+		SocialStatCycleConfigurationService:              socialStatCycleConfigurationService,
 	}
 
 	resp.DataSourceData = clients
@@ -301,9 +608,46 @@ func (p *AccelByteProvider) Configure(ctx context.Context, req provider.Configur
 
 func (p *AccelByteProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAccelByteAchievementResource,
+		NewAccelByteAmsFleetResource,
+		NewAccelByteChatConfigurationResource,
+		NewAccelByteChatProfanityDictionaryResource,
+		NewAccelByteCloudsaveAdminGameRecordResource,
+		NewAccelByteCloudsaveGameRecordResource,
+		NewAccelByteExtendAppResource,
+		NewAccelByteGroupConfigurationResource,
+		NewAccelByteGroupMemberRoleResource,
+		NewAccelByteIamClientResource,
+		NewAccelByteIamRoleResource,
+		NewAccelByteInventoryConfigurationResource,
+		NewAccelByteInventoryItemTypeResource,
+		NewAccelByteLeaderboardResource,
+		NewAccelByteLegalPolicyResource,
+		NewAccelByteLegalPolicyVersionResource,
+		NewAccelByteLobbyConfigResource,
 		NewAccelByteMatchPoolResource,
 		NewAccelByteMatchRuleSetResource,
+		NewAccelByteNamespaceResource,
+		NewAccelBytePlatformCampaignResource,
+		NewAccelBytePlatformCategoryResource,
+		NewAccelBytePlatformCurrencyResource,
+		NewAccelBytePlatformIapConfigAppleResource,
+		NewAccelBytePlatformIapConfigEpicGamesResource,
+		NewAccelBytePlatformIapConfigGoogleResource,
+		NewAccelBytePlatformIapConfigPlayStationResource,
+		NewAccelBytePlatformIapConfigSteamResource,
+		NewAccelBytePlatformIapConfigXboxResource,
+		NewAccelBytePlatformItemResource,
+		NewAccelBytePlatformRewardResource,
+		NewAccelBytePlatformStoreResource,
+		NewAccelBytePlatformStorePublishResource,
+		NewAccelByteReportingConfigurationResource,
+		NewAccelByteReportingReasonResource,
+		NewAccelByteReportingReasonGroupResource,
 		NewAccelByteSessionTemplateResource,
+		NewAccelByteStatisticResource,
+		NewAccelByteStatisticCycleResource,
+		NewAccelByteThirdPartyLoginPlatformResource,
 	}
 }
 
@@ -313,6 +657,8 @@ func (p *AccelByteProvider) EphemeralResources(ctx context.Context) []func() eph
 
 func (p *AccelByteProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAccelByteAmsImagesDataSource,
+		NewAccelByteAmsRegionsDataSource,
 		NewAccelByteMatchPoolDataSource,
 		NewAccelByteMatchRuleSetDataSource,
 		NewAccelByteSessionTemplateDataSource,
@@ -323,6 +669,28 @@ func (p *AccelByteProvider) Functions(ctx context.Context) []func() function.Fun
 	return []func() function.Function{}
 }
 
+// Used by ImportState operations on resources whose ID is on the format `{{namespace}}/{{...}}`.
+// This splits the import ID into its parts and writes each part to the corresponding attribute, so that Read() can locate the resource.
+func importStateFromCompositeId(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, attributeNames ...string) {
+	parts := strings.Split(req.ID, "/")
+
+	valid := len(parts) == len(attributeNames)
+	for _, part := range parts {
+		valid = valid && part != ""
+	}
+
+	if !valid {
+		expectedFormat := "{{" + strings.Join(attributeNames, "}}/{{") + "}}"
+		resp.Diagnostics.AddError("Unexpected import identifier", fmt.Sprintf("Expected import identifier on the format `%s`, got: '%s'", expectedFormat, req.ID))
+		return
+	}
+
+	for i, attributeName := range attributeNames {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attributeName), parts[i])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &AccelByteProvider{
@@ -4,10 +4,17 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 )
 
@@ -37,3 +44,96 @@ func testAccPreCheck(t *testing.T) {
 	// about the appropriate environment variables being set are common to see in a pre-check
 	// function.
 }
+
+// testResourceSchema returns the schema of resource `r`.
+func testResourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unable to get resource schema: %v", resp.Diagnostics)
+	}
+	return resp.Schema
+}
+
+// testObjectValue builds a value of the type described by schema `s`.
+// Attributes that are not listed in `values` are null.
+func testObjectValue(t *testing.T, s schema.Schema, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType := s.Type().TerraformType(context.Background()).(tftypes.Object)
+
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+		} else {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	for name := range values {
+		if _, ok := objectType.AttributeTypes[name]; !ok {
+			t.Fatalf("attribute '%s' is not part of the schema", name)
+		}
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func TestImportStateFromCompositeId(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{Required: true},
+			"name":      schema.StringAttribute{Required: true},
+			"id":        schema.StringAttribute{Computed: true},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		id            string
+		wantError     bool
+		wantNamespace string
+		wantName      string
+	}{
+		{name: "valid", id: "mygame/weapon", wantNamespace: "mygame", wantName: "weapon"},
+		{name: "empty", id: "", wantError: true},
+		{name: "too few parts", id: "mygame", wantError: true},
+		{name: "too many parts", id: "mygame/weapon/extra", wantError: true},
+		{name: "empty first part", id: "/weapon", wantError: true},
+		{name: "empty last part", id: "mygame/", wantError: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := resource.ImportStateRequest{ID: test.id}
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)},
+			}
+
+			importStateFromCompositeId(ctx, req, resp, "namespace", "name")
+
+			if resp.Diagnostics.HasError() != test.wantError {
+				t.Fatalf("got diagnostics %v, want error: %t", resp.Diagnostics, test.wantError)
+			}
+			if test.wantError {
+				return
+			}
+
+			var namespace, name, id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("namespace"), &namespace)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("name"), &name)...)
+			resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unable to read imported state: %v", resp.Diagnostics)
+			}
+
+			if namespace.ValueString() != test.wantNamespace || name.ValueString() != test.wantName || id.ValueString() != test.id {
+				t.Errorf("got namespace '%s', name '%s', id '%s'; want '%s', '%s', '%s'", namespace.ValueString(), name.ValueString(), id.ValueString(), test.wantNamespace, test.wantName, test.id)
+			}
+		})
+	}
+}